@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
+// cloudFrontDistributionCacheTTL is how long a GetDistribution result is
+// reused. It is kept short since the cache only exists to coalesce the reads
+// made by several resources and data sources during the same operation.
+const cloudFrontDistributionCacheTTL = 30 * time.Second
+
+// cloudFrontDistributionCache holds recent GetDistribution results by
+// distribution ID, so that reads of the same distribution made in quick
+// succession (or concurrently) result in a single API call. Each cached
+// output carries the ETag it was read with. Anything that writes to a
+// distribution must call Invalidate so the next read returns the new ETag.
+//
+// A nil *cloudFrontDistributionCache is valid and calls the API directly.
+type cloudFrontDistributionCache struct {
+	mu      sync.Mutex
+	entries map[string]*cloudFrontDistributionCacheEntry
+}
+
+type cloudFrontDistributionCacheEntry struct {
+	// ready is closed once output and err have been set.
+	ready   chan struct{}
+	output  *cloudfront.GetDistributionOutput
+	err     error
+	expires time.Time
+}
+
+func newCloudFrontDistributionCache() *cloudFrontDistributionCache {
+	return &cloudFrontDistributionCache{
+		entries: make(map[string]*cloudFrontDistributionCacheEntry),
+	}
+}
+
+// GetDistribution returns the distribution with the given ID, calling the API
+// only if there is no unexpired result and no request already in flight.
+// The returned output is shared and must not be modified.
+func (c *cloudFrontDistributionCache) GetDistribution(conn *cloudfront.CloudFront, id string) (*cloudfront.GetDistributionOutput, error) {
+	if c == nil {
+		return conn.GetDistribution(&cloudfront.GetDistributionInput{
+			Id: aws.String(id),
+		})
+	}
+
+	c.mu.Lock()
+	if entry, ok := c.entries[id]; ok && entry.usable() {
+		c.mu.Unlock()
+		<-entry.ready
+		return entry.output, entry.err
+	}
+
+	entry := &cloudFrontDistributionCacheEntry{
+		ready: make(chan struct{}),
+	}
+	c.entries[id] = entry
+	c.mu.Unlock()
+
+	output, err := conn.GetDistribution(&cloudfront.GetDistributionInput{
+		Id: aws.String(id),
+	})
+
+	c.mu.Lock()
+	entry.output = output
+	entry.err = err
+	entry.expires = time.Now().Add(cloudFrontDistributionCacheTTL)
+	// Errors are only shared with requests that were already waiting.
+	if err != nil && c.entries[id] == entry {
+		delete(c.entries, id)
+	}
+	c.mu.Unlock()
+	close(entry.ready)
+
+	return output, err
+}
+
+// Invalidate drops any cached result for the distribution with the given ID.
+func (c *cloudFrontDistributionCache) Invalidate(id string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	delete(c.entries, id)
+	c.mu.Unlock()
+}
+
+// usable reports whether the entry is still being fetched or has not yet
+// expired. It must be called with the cache's lock held.
+func (e *cloudFrontDistributionCacheEntry) usable() bool {
+	select {
+	case <-e.ready:
+		return time.Now().Before(e.expires)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
+func testCloudFrontDistributionCacheConn(t *testing.T, calls *int32, release <-chan struct{}) *cloudfront.CloudFront {
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := cloudfront.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch params := r.Params.(type) {
+		case *cloudfront.GetDistributionInput:
+			atomic.AddInt32(calls, 1)
+			if release != nil {
+				<-release
+			}
+			r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+				Id: params.Id,
+			}
+			r.Data.(*cloudfront.GetDistributionOutput).ETag = aws.String("E2QWRUHEXAMPLE")
+		}
+	})
+
+	return conn
+}
+
+func TestCloudFrontDistributionCache_GetDistribution(t *testing.T) {
+	var calls int32
+	conn := testCloudFrontDistributionCacheConn(t, &calls, nil)
+	cache := newCloudFrontDistributionCache()
+
+	for i := 0; i < 2; i++ {
+		output, err := cache.GetDistribution(conn, "E74FTE3EXAMPLE")
+		if err != nil {
+			t.Fatalf("Expected no error, received: %s", err)
+		}
+		if v := aws.StringValue(output.ETag); v != "E2QWRUHEXAMPLE" {
+			t.Fatalf("Expected ETag E2QWRUHEXAMPLE, got %q", v)
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("Expected GetDistribution to be called once, got %d", calls)
+	}
+
+	if _, err := cache.GetDistribution(conn, "E1UXSJ8EXAMPLE"); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+	if calls != 2 {
+		t.Fatalf("Expected GetDistribution to be called for a different distribution, got %d calls", calls)
+	}
+
+	cache.Invalidate("E74FTE3EXAMPLE")
+	if _, err := cache.GetDistribution(conn, "E74FTE3EXAMPLE"); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+	if calls != 3 {
+		t.Fatalf("Expected GetDistribution to be called again after Invalidate, got %d calls", calls)
+	}
+}
+
+func TestCloudFrontDistributionCache_GetDistribution_concurrent(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	conn := testCloudFrontDistributionCacheConn(t, &calls, release)
+	cache := newCloudFrontDistributionCache()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.GetDistribution(conn, "E74FTE3EXAMPLE"); err != nil {
+				t.Errorf("Expected no error, received: %s", err)
+			}
+		}()
+	}
+
+	// Let the first request through only once it has been made.
+	for atomic.LoadInt32(&calls) == 0 {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("Expected GetDistribution to be called once, got %d", calls)
+	}
+}
+
+func TestCloudFrontDistributionCache_nil(t *testing.T) {
+	var calls int32
+	conn := testCloudFrontDistributionCacheConn(t, &calls, nil)
+	var cache *cloudFrontDistributionCache
+
+	for i := 0; i < 2; i++ {
+		if _, err := cache.GetDistribution(conn, "E74FTE3EXAMPLE"); err != nil {
+			t.Fatalf("Expected no error, received: %s", err)
+		}
+	}
+	cache.Invalidate("E74FTE3EXAMPLE")
+
+	if calls != 2 {
+		t.Fatalf("Expected GetDistribution to be called for every read, got %d", calls)
+	}
+}
@@ -10,7 +10,9 @@ package aws
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -32,20 +34,38 @@ const cloudFrontRoute53ZoneID = "Z2FDTNDATAQYW2"
 // Used by the aws_cloudfront_distribution Create and Update functions.
 func expandDistributionConfig(d *schema.ResourceData) *cloudfront.DistributionConfig {
 	distributionConfig := &cloudfront.DistributionConfig{
-		CacheBehaviors:       expandCacheBehaviors(d.Get("ordered_cache_behavior").([]interface{})),
 		CallerReference:      aws.String(time.Now().Format(time.RFC3339Nano)),
 		Comment:              aws.String(d.Get("comment").(string)),
 		CustomErrorResponses: expandCustomErrorResponses(d.Get("custom_error_response").(*schema.Set)),
-		DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(d.Get("default_cache_behavior").([]interface{})[0].(map[string]interface{})),
 		DefaultRootObject:    aws.String(d.Get("default_root_object").(string)),
 		Enabled:              aws.Bool(d.Get("enabled").(bool)),
 		IsIPV6Enabled:        aws.Bool(d.Get("is_ipv6_enabled").(bool)),
 		HttpVersion:          aws.String(d.Get("http_version").(string)),
 		Origins:              expandOrigins(d.Get("origin").(*schema.Set)),
+		OriginGroups:         expandOriginGroups(d.Get("origin_group").(*schema.Set)),
 		PriceClass:           aws.String(d.Get("price_class").(string)),
 		WebACLId:             aws.String(d.Get("web_acl_id").(string)),
 	}
 
+	// The block is required, but the
+	// aws_cloudfront_distribution_config_validation data source also expands
+	// configurations that omit it.
+	if v := d.Get("default_cache_behavior").([]interface{}); len(v) > 0 && v[0] != nil {
+		distributionConfig.DefaultCacheBehavior = expandCloudFrontDefaultCacheBehavior(v[0].(map[string]interface{}))
+	}
+
+	_, orderedCacheBehaviors := cloudFrontDistributionOrderedCacheBehaviors(d)
+	distributionConfig.CacheBehaviors = expandCacheBehaviors(orderedCacheBehaviors)
+
+	// Infer the default behavior's target when there is only one origin
+	if distributionConfig.DefaultCacheBehavior != nil && aws.StringValue(distributionConfig.DefaultCacheBehavior.TargetOriginId) == "" && len(distributionConfig.Origins.Items) == 1 {
+		distributionConfig.DefaultCacheBehavior.TargetOriginId = distributionConfig.Origins.Items[0].Id
+	}
+
+	if v, ok := d.GetOk("web_acl_arn"); ok {
+		distributionConfig.WebACLId = aws.String(v.(string))
+	}
+
 	// This sets CallerReference if it's still pending computation (ie: new resource)
 	if v, ok := d.GetOk("caller_reference"); ok {
 		distributionConfig.CallerReference = aws.String(v.(string))
@@ -80,8 +100,24 @@ func expandDistributionConfig(d *schema.ResourceData) *cloudfront.DistributionCo
 func flattenDistributionConfig(d *schema.ResourceData, distributionConfig *cloudfront.DistributionConfig) error {
 	var err error
 
+	// A distribution created by other tools may not have the shape the
+	// schema requires. Report it rather than failing on a nil pointer.
+	if distributionConfig == nil {
+		return fmt.Errorf("CloudFront returned no distribution configuration")
+	}
+	if distributionConfig.DefaultCacheBehavior == nil {
+		return fmt.Errorf("CloudFront distribution configuration has no default_cache_behavior")
+	}
+	if distributionConfig.Origins == nil {
+		return fmt.Errorf("CloudFront distribution configuration has no origin")
+	}
+	if distributionConfig.ViewerCertificate == nil {
+		return fmt.Errorf("CloudFront distribution configuration has no viewer_certificate")
+	}
+
 	d.Set("enabled", distributionConfig.Enabled)
-	d.Set("is_ipv6_enabled", distributionConfig.IsIPV6Enabled)
+	// Always set a value, so imports match the schema default of false
+	d.Set("is_ipv6_enabled", aws.BoolValue(distributionConfig.IsIPV6Enabled))
 	d.Set("price_class", distributionConfig.PriceClass)
 	d.Set("hosted_zone_id", cloudFrontRoute53ZoneID)
 
@@ -97,19 +133,24 @@ func flattenDistributionConfig(d *schema.ResourceData, distributionConfig *cloud
 	if distributionConfig.CallerReference != nil {
 		d.Set("caller_reference", distributionConfig.CallerReference)
 	}
-	if distributionConfig.Comment != nil {
-		if *distributionConfig.Comment != "" {
-			d.Set("comment", distributionConfig.Comment)
-		}
-	}
-	if distributionConfig.DefaultRootObject != nil {
-		d.Set("default_root_object", distributionConfig.DefaultRootObject)
-	}
+	// Always set comment so that clearing it is reflected in state rather
+	// than leaving the previous text behind.
+	d.Set("comment", aws.StringValue(distributionConfig.Comment))
+	// Always set default_root_object so that removing it from the
+	// configuration is reflected in state once CloudFront has cleared it.
+	d.Set("default_root_object", aws.StringValue(distributionConfig.DefaultRootObject))
 	if distributionConfig.HttpVersion != nil {
 		d.Set("http_version", distributionConfig.HttpVersion)
 	}
 	if distributionConfig.WebACLId != nil {
-		d.Set("web_acl_id", distributionConfig.WebACLId)
+		// Report the web ACL under whichever argument the configuration uses.
+		if d.Get("web_acl_arn").(string) != "" && strings.HasPrefix(aws.StringValue(distributionConfig.WebACLId), "arn:") {
+			d.Set("web_acl_arn", distributionConfig.WebACLId)
+			d.Set("web_acl_id", "")
+		} else {
+			d.Set("web_acl_arn", "")
+			d.Set("web_acl_id", distributionConfig.WebACLId)
+		}
 	}
 
 	if distributionConfig.CustomErrorResponses != nil {
@@ -119,12 +160,22 @@ func flattenDistributionConfig(d *schema.ResourceData, distributionConfig *cloud
 		}
 	}
 	if distributionConfig.CacheBehaviors != nil {
-		if err := d.Set("ordered_cache_behavior", flattenCacheBehaviors(distributionConfig.CacheBehaviors)); err != nil {
+		// Keep using ordered_cache_behavior_by_path if the configuration does
+		if d.Get("ordered_cache_behavior_by_path").(*schema.Set).Len() > 0 {
+			if err := d.Set("ordered_cache_behavior_by_path", flattenCacheBehaviorsByPath(distributionConfig.CacheBehaviors, d.Get("ordered_cache_behavior_by_path").(*schema.Set))); err != nil {
+				return err
+			}
+		} else if err := d.Set("ordered_cache_behavior", flattenCacheBehaviors(distributionConfig.CacheBehaviors)); err != nil {
 			return err
 		}
 	}
 
-	if distributionConfig.Logging != nil && *distributionConfig.Logging.Enabled {
+	err = d.Set("attached_functions", flattenCloudFrontAttachedFunctions(distributionConfig))
+	if err != nil {
+		return err
+	}
+
+	if distributionConfig.Logging != nil && aws.BoolValue(distributionConfig.Logging.Enabled) {
 		err = d.Set("logging_config", flattenLoggingConfig(distributionConfig.Logging))
 	} else {
 		err = d.Set("logging_config", []interface{}{})
@@ -138,23 +189,98 @@ func flattenDistributionConfig(d *schema.ResourceData, distributionConfig *cloud
 		if err != nil {
 			return err
 		}
+		err = d.Set("effective_aliases", flattenStringList(distributionConfig.Aliases.Items))
+	} else {
+		err = d.Set("effective_aliases", []interface{}{})
 	}
-	if distributionConfig.Restrictions != nil {
+	if err != nil {
+		return err
+	}
+	if distributionConfig.Restrictions != nil && distributionConfig.Restrictions.GeoRestriction != nil {
 		err = d.Set("restrictions", flattenRestrictions(distributionConfig.Restrictions))
 		if err != nil {
 			return err
 		}
 	}
-	if *distributionConfig.Origins.Quantity > 0 {
+	if aws.Int64Value(distributionConfig.Origins.Quantity) > 0 {
 		err = d.Set("origin", flattenOrigins(distributionConfig.Origins))
 		if err != nil {
 			return err
 		}
 	}
+	// Always set origin_group so that groups removed outside of Terraform
+	// are detected
+	if distributionConfig.OriginGroups != nil {
+		err = d.Set("origin_group", flattenOriginGroups(distributionConfig.OriginGroups))
+	} else {
+		err = d.Set("origin_group", schema.NewSet(originGroupHash, []interface{}{}))
+	}
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// flattenCloudFrontAttachedFunctions lists the Lambda@Edge associations of
+// every cache behavior, default behavior first and then in precedence order.
+// The default behavior is reported with the path pattern "*".
+func flattenCloudFrontAttachedFunctions(distributionConfig *cloudfront.DistributionConfig) []interface{} {
+	lst := []interface{}{}
+	add := func(pathPattern string, lfa *cloudfront.LambdaFunctionAssociations) {
+		if lfa == nil {
+			return
+		}
+		// Associations have no order of their own, so report them in the
+		// order the events happen during a request.
+		items := make([]*cloudfront.LambdaFunctionAssociation, 0, len(lfa.Items))
+		for _, v := range lfa.Items {
+			if v != nil {
+				items = append(items, v)
+			}
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			return cloudFrontLambdaEventTypeOrder(aws.StringValue(items[i].EventType)) < cloudFrontLambdaEventTypeOrder(aws.StringValue(items[j].EventType))
+		})
+		for _, v := range items {
+			lst = append(lst, map[string]interface{}{
+				"arn":          aws.StringValue(v.LambdaFunctionARN),
+				"event_type":   aws.StringValue(v.EventType),
+				"path_pattern": pathPattern,
+			})
+		}
+	}
+
+	if distributionConfig.DefaultCacheBehavior != nil {
+		add("*", distributionConfig.DefaultCacheBehavior.LambdaFunctionAssociations)
+	}
+	if distributionConfig.CacheBehaviors != nil {
+		for _, v := range distributionConfig.CacheBehaviors.Items {
+			if v != nil {
+				add(aws.StringValue(v.PathPattern), v.LambdaFunctionAssociations)
+			}
+		}
+	}
+
+	return lst
+}
+
+// cloudFrontLambdaEventTypeOrder returns the position of a Lambda@Edge event
+// type in the request lifecycle. Unknown event types sort last.
+func cloudFrontLambdaEventTypeOrder(eventType string) int {
+	switch eventType {
+	case cloudfront.EventTypeViewerRequest:
+		return 0
+	case cloudfront.EventTypeOriginRequest:
+		return 1
+	case cloudfront.EventTypeOriginResponse:
+		return 2
+	case cloudfront.EventTypeViewerResponse:
+		return 3
+	}
+	return 4
+}
+
 func flattenDefaultCacheBehavior(dcb *cloudfront.DefaultCacheBehavior) []interface{} {
 	return []interface{}{flattenCloudFrontDefaultCacheBehavior(dcb)}
 }
@@ -175,11 +301,43 @@ func expandCacheBehaviors(lst []interface{}) *cloudfront.CacheBehaviors {
 func flattenCacheBehaviors(cbs *cloudfront.CacheBehaviors) []interface{} {
 	lst := []interface{}{}
 	for _, v := range cbs.Items {
+		if v == nil {
+			continue
+		}
 		lst = append(lst, flattenCacheBehavior(v))
 	}
 	return lst
 }
 
+// flattenCacheBehaviorsByPath flattens the cache behaviors for
+// ordered_cache_behavior_by_path. Each behavior keeps the precedence it had in
+// prior as long as that is consistent with CloudFront's order; otherwise it is
+// given the next precedence after the previous behavior's.
+func flattenCacheBehaviorsByPath(cbs *cloudfront.CacheBehaviors, prior *schema.Set) []interface{} {
+	priorPrecedences := make(map[string]int)
+	for _, raw := range prior.List() {
+		m := raw.(map[string]interface{})
+		priorPrecedences[m["path_pattern"].(string)] = m["precedence"].(int)
+	}
+
+	lst := []interface{}{}
+	precedence, pathPattern := -1, ""
+	for _, v := range cbs.Items {
+		if v == nil {
+			continue
+		}
+		m := flattenCacheBehavior(v)
+		p, ok := priorPrecedences[m["path_pattern"].(string)]
+		if !ok || p < precedence || (p == precedence && m["path_pattern"].(string) <= pathPattern) {
+			p = precedence + 1
+		}
+		precedence, pathPattern = p, m["path_pattern"].(string)
+		m["precedence"] = precedence
+		lst = append(lst, m)
+	}
+	return lst
+}
+
 func expandCloudFrontDefaultCacheBehavior(m map[string]interface{}) *cloudfront.DefaultCacheBehavior {
 	dcb := &cloudfront.DefaultCacheBehavior{
 		Compress:               aws.Bool(m["compress"].(bool)),
@@ -258,14 +416,16 @@ func flattenCloudFrontDefaultCacheBehavior(dcb *cloudfront.DefaultCacheBehavior)
 		"field_level_encryption_id": aws.StringValue(dcb.FieldLevelEncryptionId),
 		"viewer_protocol_policy":    aws.StringValue(dcb.ViewerProtocolPolicy),
 		"target_origin_id":          aws.StringValue(dcb.TargetOriginId),
-		"forwarded_values":          []interface{}{flattenForwardedValues(dcb.ForwardedValues)},
 		"min_ttl":                   aws.Int64Value(dcb.MinTTL),
 	}
 
-	if len(dcb.TrustedSigners.Items) > 0 {
+	if dcb.ForwardedValues != nil {
+		m["forwarded_values"] = []interface{}{flattenForwardedValues(dcb.ForwardedValues)}
+	}
+	if dcb.TrustedSigners != nil && len(dcb.TrustedSigners.Items) > 0 {
 		m["trusted_signers"] = flattenTrustedSigners(dcb.TrustedSigners)
 	}
-	if len(dcb.LambdaFunctionAssociations.Items) > 0 {
+	if dcb.LambdaFunctionAssociations != nil && len(dcb.LambdaFunctionAssociations.Items) > 0 {
 		m["lambda_function_association"] = flattenLambdaFunctionAssociations(dcb.LambdaFunctionAssociations)
 	}
 	if dcb.MaxTTL != nil {
@@ -280,7 +440,7 @@ func flattenCloudFrontDefaultCacheBehavior(dcb *cloudfront.DefaultCacheBehavior)
 	if dcb.AllowedMethods != nil {
 		m["allowed_methods"] = flattenAllowedMethods(dcb.AllowedMethods)
 	}
-	if dcb.AllowedMethods.CachedMethods != nil {
+	if dcb.AllowedMethods != nil && dcb.AllowedMethods.CachedMethods != nil {
 		m["cached_methods"] = flattenCachedMethods(dcb.AllowedMethods.CachedMethods)
 	}
 
@@ -290,17 +450,19 @@ func flattenCloudFrontDefaultCacheBehavior(dcb *cloudfront.DefaultCacheBehavior)
 func flattenCacheBehavior(cb *cloudfront.CacheBehavior) map[string]interface{} {
 	m := make(map[string]interface{})
 
-	m["compress"] = *cb.Compress
+	m["compress"] = aws.BoolValue(cb.Compress)
 	m["field_level_encryption_id"] = aws.StringValue(cb.FieldLevelEncryptionId)
-	m["viewer_protocol_policy"] = *cb.ViewerProtocolPolicy
-	m["target_origin_id"] = *cb.TargetOriginId
-	m["forwarded_values"] = []interface{}{flattenForwardedValues(cb.ForwardedValues)}
-	m["min_ttl"] = int(*cb.MinTTL)
+	m["viewer_protocol_policy"] = aws.StringValue(cb.ViewerProtocolPolicy)
+	m["target_origin_id"] = aws.StringValue(cb.TargetOriginId)
+	m["min_ttl"] = int(aws.Int64Value(cb.MinTTL))
 
-	if len(cb.TrustedSigners.Items) > 0 {
+	if cb.ForwardedValues != nil {
+		m["forwarded_values"] = []interface{}{flattenForwardedValues(cb.ForwardedValues)}
+	}
+	if cb.TrustedSigners != nil && len(cb.TrustedSigners.Items) > 0 {
 		m["trusted_signers"] = flattenTrustedSigners(cb.TrustedSigners)
 	}
-	if len(cb.LambdaFunctionAssociations.Items) > 0 {
+	if cb.LambdaFunctionAssociations != nil && len(cb.LambdaFunctionAssociations.Items) > 0 {
 		m["lambda_function_association"] = flattenLambdaFunctionAssociations(cb.LambdaFunctionAssociations)
 	}
 	if cb.MaxTTL != nil {
@@ -315,12 +477,11 @@ func flattenCacheBehavior(cb *cloudfront.CacheBehavior) map[string]interface{} {
 	if cb.AllowedMethods != nil {
 		m["allowed_methods"] = flattenAllowedMethods(cb.AllowedMethods)
 	}
-	if cb.AllowedMethods.CachedMethods != nil {
+	if cb.AllowedMethods != nil && cb.AllowedMethods.CachedMethods != nil {
 		m["cached_methods"] = flattenCachedMethods(cb.AllowedMethods.CachedMethods)
 	}
-	if cb.PathPattern != nil {
-		m["path_pattern"] = *cb.PathPattern
-	}
+	m["path_pattern"] = aws.StringValue(cb.PathPattern)
+	m["id"] = aws.StringValue(cb.PathPattern)
 	return m
 }
 
@@ -395,9 +556,9 @@ func flattenLambdaFunctionAssociations(lfa *cloudfront.LambdaFunctionAssociation
 func flattenLambdaFunctionAssociation(lfa *cloudfront.LambdaFunctionAssociation) map[string]interface{} {
 	m := map[string]interface{}{}
 	if lfa != nil {
-		m["event_type"] = *lfa.EventType
-		m["lambda_arn"] = *lfa.LambdaFunctionARN
-		m["include_body"] = *lfa.IncludeBody
+		m["event_type"] = aws.StringValue(lfa.EventType)
+		m["lambda_arn"] = aws.StringValue(lfa.LambdaFunctionARN)
+		m["include_body"] = aws.BoolValue(lfa.IncludeBody)
 	}
 	return m
 }
@@ -410,7 +571,7 @@ func expandForwardedValues(m map[string]interface{}) *cloudfront.ForwardedValues
 		fv.Cookies = expandCookiePreference(v.([]interface{})[0].(map[string]interface{}))
 	}
 	if v, ok := m["headers"]; ok {
-		fv.Headers = expandHeaders(v.([]interface{}))
+		fv.Headers = expandHeaders(v.(*schema.Set))
 	}
 	if v, ok := m["query_string_cache_keys"]; ok {
 		fv.QueryStringCacheKeys = expandQueryStringCacheKeys(v.([]interface{}))
@@ -420,7 +581,7 @@ func expandForwardedValues(m map[string]interface{}) *cloudfront.ForwardedValues
 
 func flattenForwardedValues(fv *cloudfront.ForwardedValues) map[string]interface{} {
 	m := make(map[string]interface{})
-	m["query_string"] = *fv.QueryString
+	m["query_string"] = aws.BoolValue(fv.QueryString)
 	if fv.Cookies != nil {
 		m["cookies"] = []interface{}{flattenCookiePreference(fv.Cookies)}
 	}
@@ -433,18 +594,18 @@ func flattenForwardedValues(fv *cloudfront.ForwardedValues) map[string]interface
 	return m
 }
 
-func expandHeaders(d []interface{}) *cloudfront.Headers {
+func expandHeaders(s *schema.Set) *cloudfront.Headers {
 	return &cloudfront.Headers{
-		Quantity: aws.Int64(int64(len(d))),
-		Items:    expandStringList(d),
+		Quantity: aws.Int64(int64(s.Len())),
+		Items:    expandStringList(s.List()),
 	}
 }
 
-func flattenHeaders(h *cloudfront.Headers) []interface{} {
+func flattenHeaders(h *cloudfront.Headers) *schema.Set {
 	if h.Items != nil {
-		return flattenStringList(h.Items)
+		return schema.NewSet(schema.HashString, flattenStringList(h.Items))
 	}
-	return []interface{}{}
+	return schema.NewSet(schema.HashString, []interface{}{})
 }
 
 func expandQueryStringCacheKeys(d []interface{}) *cloudfront.QueryStringCacheKeys {
@@ -466,32 +627,32 @@ func expandCookiePreference(m map[string]interface{}) *cloudfront.CookiePreferen
 		Forward: aws.String(m["forward"].(string)),
 	}
 	if v, ok := m["whitelisted_names"]; ok {
-		cp.WhitelistedNames = expandCookieNames(v.([]interface{}))
+		cp.WhitelistedNames = expandCookieNames(v.(*schema.Set))
 	}
 	return cp
 }
 
 func flattenCookiePreference(cp *cloudfront.CookiePreference) map[string]interface{} {
 	m := make(map[string]interface{})
-	m["forward"] = *cp.Forward
+	m["forward"] = aws.StringValue(cp.Forward)
 	if cp.WhitelistedNames != nil {
 		m["whitelisted_names"] = flattenCookieNames(cp.WhitelistedNames)
 	}
 	return m
 }
 
-func expandCookieNames(d []interface{}) *cloudfront.CookieNames {
+func expandCookieNames(s *schema.Set) *cloudfront.CookieNames {
 	return &cloudfront.CookieNames{
-		Quantity: aws.Int64(int64(len(d))),
-		Items:    expandStringList(d),
+		Quantity: aws.Int64(int64(s.Len())),
+		Items:    expandStringList(s.List()),
 	}
 }
 
-func flattenCookieNames(cn *cloudfront.CookieNames) []interface{} {
+func flattenCookieNames(cn *cloudfront.CookieNames) *schema.Set {
 	if cn.Items != nil {
-		return flattenStringList(cn.Items)
+		return schema.NewSet(schema.HashString, flattenStringList(cn.Items))
 	}
-	return []interface{}{}
+	return schema.NewSet(schema.HashString, []interface{}{})
 }
 
 func expandAllowedMethods(s *schema.Set) *cloudfront.AllowedMethods {
@@ -505,7 +666,7 @@ func flattenAllowedMethods(am *cloudfront.AllowedMethods) *schema.Set {
 	if am.Items != nil {
 		return schema.NewSet(schema.HashString, flattenStringList(am.Items))
 	}
-	return nil
+	return schema.NewSet(schema.HashString, []interface{}{})
 }
 
 func expandCachedMethods(s *schema.Set) *cloudfront.CachedMethods {
@@ -519,7 +680,7 @@ func flattenCachedMethods(cm *cloudfront.CachedMethods) *schema.Set {
 	if cm.Items != nil {
 		return schema.NewSet(schema.HashString, flattenStringList(cm.Items))
 	}
-	return nil
+	return schema.NewSet(schema.HashString, []interface{}{})
 }
 
 func expandOrigins(s *schema.Set) *cloudfront.Origins {
@@ -538,6 +699,9 @@ func expandOrigins(s *schema.Set) *cloudfront.Origins {
 func flattenOrigins(ors *cloudfront.Origins) *schema.Set {
 	s := []interface{}{}
 	for _, v := range ors.Items {
+		if v == nil {
+			continue
+		}
 		s = append(s, flattenOrigin(v))
 	}
 	return schema.NewSet(originHash, s)
@@ -552,7 +716,7 @@ func expandOrigin(m map[string]interface{}) *cloudfront.Origin {
 		origin.CustomHeaders = expandCustomHeaders(v.(*schema.Set))
 	}
 	if v, ok := m["custom_origin_config"]; ok {
-		if s := v.([]interface{}); len(s) > 0 {
+		if s := v.([]interface{}); len(s) > 0 && s[0] != nil {
 			origin.CustomOriginConfig = expandCustomOriginConfig(s[0].(map[string]interface{}))
 		}
 	}
@@ -560,7 +724,7 @@ func expandOrigin(m map[string]interface{}) *cloudfront.Origin {
 		origin.OriginPath = aws.String(v.(string))
 	}
 	if v, ok := m["s3_origin_config"]; ok {
-		if s := v.([]interface{}); len(s) > 0 {
+		if s := v.([]interface{}); len(s) > 0 && s[0] != nil {
 			origin.S3OriginConfig = expandS3OriginConfig(s[0].(map[string]interface{}))
 		}
 	}
@@ -621,6 +785,136 @@ func originHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
+func expandOriginGroups(s *schema.Set) *cloudfront.OriginGroups {
+	qty := 0
+	items := []*cloudfront.OriginGroup{}
+	for _, v := range s.List() {
+		items = append(items, expandOriginGroup(v.(map[string]interface{})))
+		qty++
+	}
+	return &cloudfront.OriginGroups{
+		Quantity: aws.Int64(int64(qty)),
+		Items:    items,
+	}
+}
+
+func flattenOriginGroups(ogs *cloudfront.OriginGroups) *schema.Set {
+	s := []interface{}{}
+	for _, v := range ogs.Items {
+		if v == nil {
+			continue
+		}
+		s = append(s, flattenOriginGroup(v))
+	}
+	return schema.NewSet(originGroupHash, s)
+}
+
+func expandOriginGroup(m map[string]interface{}) *cloudfront.OriginGroup {
+	failoverCriteria := m["failover_criteria"].([]interface{})[0].(map[string]interface{})
+	return &cloudfront.OriginGroup{
+		Id:               aws.String(m["origin_id"].(string)),
+		FailoverCriteria: expandOriginGroupFailoverCriteria(failoverCriteria),
+		Members:          expandOriginGroupMembers(m["member"].([]interface{})),
+	}
+}
+
+func flattenOriginGroup(og *cloudfront.OriginGroup) map[string]interface{} {
+	m := make(map[string]interface{})
+	m["origin_id"] = aws.StringValue(og.Id)
+	if og.FailoverCriteria != nil {
+		m["failover_criteria"] = []interface{}{flattenOriginGroupFailoverCriteria(og.FailoverCriteria)}
+	}
+	if og.Members != nil {
+		m["member"] = flattenOriginGroupMembers(og.Members)
+	}
+	return m
+}
+
+func expandOriginGroupFailoverCriteria(m map[string]interface{}) *cloudfront.OriginGroupFailoverCriteria {
+	codes := []*int64{}
+	for _, v := range m["status_codes"].(*schema.Set).List() {
+		codes = append(codes, aws.Int64(int64(v.(int))))
+	}
+	return &cloudfront.OriginGroupFailoverCriteria{
+		StatusCodes: &cloudfront.StatusCodes{
+			Quantity: aws.Int64(int64(len(codes))),
+			Items:    codes,
+		},
+	}
+}
+
+// flattenOriginGroupFailoverCriteria flattens the failover criteria the SDK
+// knows about. Criteria CloudFront adds later are dropped by the SDK when it
+// reads the response, so they do not cause errors here; they only show up
+// once the SDK and schema support them.
+func flattenOriginGroupFailoverCriteria(ogfc *cloudfront.OriginGroupFailoverCriteria) map[string]interface{} {
+	s := []interface{}{}
+	if ogfc.StatusCodes != nil {
+		for _, v := range ogfc.StatusCodes.Items {
+			if v == nil {
+				continue
+			}
+			s = append(s, int(aws.Int64Value(v)))
+		}
+	}
+	return map[string]interface{}{
+		"status_codes": schema.NewSet(schema.HashInt, s),
+	}
+}
+
+func expandOriginGroupMembers(l []interface{}) *cloudfront.OriginGroupMembers {
+	items := []*cloudfront.OriginGroupMember{}
+	for _, v := range l {
+		items = append(items, &cloudfront.OriginGroupMember{
+			OriginId: aws.String(v.(map[string]interface{})["origin_id"].(string)),
+		})
+	}
+	return &cloudfront.OriginGroupMembers{
+		Quantity: aws.Int64(int64(len(items))),
+		Items:    items,
+	}
+}
+
+func flattenOriginGroupMembers(ogm *cloudfront.OriginGroupMembers) []interface{} {
+	l := []interface{}{}
+	for _, v := range ogm.Items {
+		if v == nil {
+			continue
+		}
+		l = append(l, map[string]interface{}{
+			"origin_id": aws.StringValue(v.OriginId),
+		})
+	}
+	return l
+}
+
+// Assemble the hash for the aws_cloudfront_distribution origin_group
+// TypeSet attribute.
+func originGroupHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["origin_id"].(string)))
+	if v, ok := m["failover_criteria"]; ok {
+		if l := v.([]interface{}); len(l) > 0 && l[0] != nil {
+			fc := l[0].(map[string]interface{})
+			if v, ok := fc["status_codes"]; ok {
+				for _, code := range v.(*schema.Set).List() {
+					buf.WriteString(fmt.Sprintf("%d-", code.(int)))
+				}
+			}
+		}
+	}
+	if v, ok := m["member"]; ok {
+		for _, member := range v.([]interface{}) {
+			if member == nil {
+				continue
+			}
+			buf.WriteString(fmt.Sprintf("%s-", member.(map[string]interface{})["origin_id"].(string)))
+		}
+	}
+	return hashcode.String(buf.String())
+}
+
 func expandCustomHeaders(s *schema.Set) *cloudfront.CustomHeaders {
 	qty := 0
 	items := []*cloudfront.OriginCustomHeader{}
@@ -651,8 +945,8 @@ func expandOriginCustomHeader(m map[string]interface{}) *cloudfront.OriginCustom
 
 func flattenOriginCustomHeader(och *cloudfront.OriginCustomHeader) map[string]interface{} {
 	return map[string]interface{}{
-		"name":  *och.HeaderName,
-		"value": *och.HeaderValue,
+		"name":  aws.StringValue(och.HeaderName),
+		"value": aws.StringValue(och.HeaderValue),
 	}
 }
 
@@ -693,12 +987,12 @@ func expandCustomOriginConfig(m map[string]interface{}) *cloudfront.CustomOrigin
 func flattenCustomOriginConfig(cor *cloudfront.CustomOriginConfig) map[string]interface{} {
 
 	customOrigin := map[string]interface{}{
-		"origin_protocol_policy":   *cor.OriginProtocolPolicy,
-		"http_port":                int(*cor.HTTPPort),
-		"https_port":               int(*cor.HTTPSPort),
+		"origin_protocol_policy":   aws.StringValue(cor.OriginProtocolPolicy),
+		"http_port":                int(aws.Int64Value(cor.HTTPPort)),
+		"https_port":               int(aws.Int64Value(cor.HTTPSPort)),
 		"origin_ssl_protocols":     flattenCustomOriginConfigSSL(cor.OriginSslProtocols),
-		"origin_read_timeout":      int(*cor.OriginReadTimeout),
-		"origin_keepalive_timeout": int(*cor.OriginKeepaliveTimeout),
+		"origin_read_timeout":      int(aws.Int64Value(cor.OriginReadTimeout)),
+		"origin_keepalive_timeout": int(aws.Int64Value(cor.OriginKeepaliveTimeout)),
 	}
 
 	return customOrigin
@@ -730,6 +1024,9 @@ func expandCustomOriginConfigSSL(s []interface{}) *cloudfront.OriginSslProtocols
 }
 
 func flattenCustomOriginConfigSSL(osp *cloudfront.OriginSslProtocols) *schema.Set {
+	if osp == nil {
+		return schema.NewSet(schema.HashString, []interface{}{})
+	}
 	return schema.NewSet(schema.HashString, flattenStringList(osp.Items))
 }
 
@@ -796,7 +1093,7 @@ func expandCustomErrorResponse(m map[string]interface{}) *cloudfront.CustomError
 
 func flattenCustomErrorResponse(er *cloudfront.CustomErrorResponse) map[string]interface{} {
 	m := make(map[string]interface{})
-	m["error_code"] = int(*er.ErrorCode)
+	m["error_code"] = int(aws.Int64Value(er.ErrorCode))
 	if er.ErrorCachingMinTTL != nil {
 		m["error_caching_min_ttl"] = int(*er.ErrorCachingMinTTL)
 	}
@@ -936,20 +1233,44 @@ func expandViewerCertificate(m map[string]interface{}) *cloudfront.ViewerCertifi
 func flattenViewerCertificate(vc *cloudfront.ViewerCertificate) []interface{} {
 	m := make(map[string]interface{})
 
-	if vc.IAMCertificateId != nil {
-		m["iam_certificate_id"] = *vc.IAMCertificateId
-		m["ssl_support_method"] = *vc.SSLSupportMethod
+	// Distributions created with the deprecated Certificate and
+	// CertificateSource fields may not have the certificate's ID or ARN in
+	// the newer fields.
+	iamCertificateId := aws.StringValue(vc.IAMCertificateId)
+	acmCertificateArn := aws.StringValue(vc.ACMCertificateArn)
+	certificateSource := aws.StringValue(vc.CertificateSource)
+	switch certificateSource {
+	case cloudfront.CertificateSourceIam:
+		if iamCertificateId == "" {
+			iamCertificateId = aws.StringValue(vc.Certificate)
+		}
+	case cloudfront.CertificateSourceAcm:
+		if acmCertificateArn == "" {
+			acmCertificateArn = aws.StringValue(vc.Certificate)
+		}
 	}
-	if vc.ACMCertificateArn != nil {
-		m["acm_certificate_arn"] = *vc.ACMCertificateArn
-		m["ssl_support_method"] = *vc.SSLSupportMethod
+
+	if iamCertificateId != "" {
+		m["iam_certificate_id"] = iamCertificateId
+		m["ssl_support_method"] = aws.StringValue(vc.SSLSupportMethod)
+		certificateSource = cloudfront.CertificateSourceIam
 	}
-	if vc.CloudFrontDefaultCertificate != nil {
-		m["cloudfront_default_certificate"] = *vc.CloudFrontDefaultCertificate
+	if acmCertificateArn != "" {
+		m["acm_certificate_arn"] = acmCertificateArn
+		m["ssl_support_method"] = aws.StringValue(vc.SSLSupportMethod)
+		certificateSource = cloudfront.CertificateSourceAcm
 	}
-	if vc.MinimumProtocolVersion != nil {
-		m["minimum_protocol_version"] = *vc.MinimumProtocolVersion
+	if vc.CloudFrontDefaultCertificate != nil {
+		m["cloudfront_default_certificate"] = aws.BoolValue(vc.CloudFrontDefaultCertificate)
+		if aws.BoolValue(vc.CloudFrontDefaultCertificate) {
+			certificateSource = cloudfront.CertificateSourceCloudfront
+		}
 	}
+	m["certificate_source"] = certificateSource
+	// Always report the value CloudFront is enforcing, which may be newer
+	// than the one configured if the distribution was upgraded outside of
+	// Terraform.
+	m["minimum_protocol_version"] = aws.StringValue(vc.MinimumProtocolVersion)
 	return []interface{}{m}
 }
 
@@ -959,12 +1280,19 @@ func flattenViewerCertificate(vc *cloudfront.ViewerCertificate) []interface{} {
 func flattenActiveTrustedSigners(ats *cloudfront.ActiveTrustedSigners) flatmap.Map {
 	m := make(map[string]interface{})
 	s := []interface{}{}
-	m["enabled"] = *ats.Enabled
+	if ats == nil {
+		ats = &cloudfront.ActiveTrustedSigners{}
+	}
+	m["enabled"] = aws.BoolValue(ats.Enabled)
 
 	for _, v := range ats.Items {
 		signer := make(map[string]interface{})
-		signer["aws_account_number"] = *v.AwsAccountNumber
-		signer["key_pair_ids"] = aws.StringValueSlice(v.KeyPairIds.Items)
+		signer["aws_account_number"] = aws.StringValue(v.AwsAccountNumber)
+		var keyPairIds []*string
+		if v.KeyPairIds != nil {
+			keyPairIds = v.KeyPairIds.Items
+		}
+		signer["key_pair_ids"] = aws.StringValueSlice(keyPairIds)
 		s = append(s, signer)
 	}
 	m["items"] = s
@@ -1,7 +1,9 @@
 package aws
 
 import (
+	"fmt"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -57,8 +59,8 @@ func forwardedValuesConf() map[string]interface{} {
 	}
 }
 
-func headersConf() []interface{} {
-	return []interface{}{"X-Example1", "X-Example2"}
+func headersConf() *schema.Set {
+	return schema.NewSet(schema.HashString, []interface{}{"X-Example1", "X-Example2"})
 }
 
 func queryStringCacheKeysConf() []interface{} {
@@ -72,8 +74,8 @@ func cookiePreferenceConf() map[string]interface{} {
 	}
 }
 
-func cookieNamesConf() []interface{} {
-	return []interface{}{"Example1", "Example2"}
+func cookieNamesConf() *schema.Set {
+	return schema.NewSet(schema.HashString, []interface{}{"Example1", "Example2"})
 }
 
 func allowedMethodsConf() *schema.Set {
@@ -146,6 +148,25 @@ func multiOriginConf() *schema.Set {
 	return schema.NewSet(originHash, []interface{}{originWithCustomConf(), originWithS3Conf()})
 }
 
+func originGroupConf() map[string]interface{} {
+	return map[string]interface{}{
+		"origin_id": "OriginGroup",
+		"failover_criteria": []interface{}{
+			map[string]interface{}{
+				"status_codes": schema.NewSet(schema.HashInt, []interface{}{500, 502, 503, 504}),
+			},
+		},
+		"member": []interface{}{
+			map[string]interface{}{"origin_id": "CustomOrigin"},
+			map[string]interface{}{"origin_id": "S3Origin"},
+		},
+	}
+}
+
+func originGroupsConf() *schema.Set {
+	return schema.NewSet(originGroupHash, []interface{}{originGroupConf()})
+}
+
 func geoRestrictionWhitelistConf() map[string]interface{} {
 	return map[string]interface{}{
 		"restriction_type": "whitelist",
@@ -254,7 +275,7 @@ func TestCloudFrontStructure_expandCloudFrontDefaultCacheBehavior(t *testing.T)
 	if *dcb.TargetOriginId != "myS3Origin" {
 		t.Fatalf("Expected TargetOriginId to be allow-all, got %v", *dcb.TargetOriginId)
 	}
-	if !reflect.DeepEqual(dcb.ForwardedValues.Headers.Items, expandStringList(headersConf())) {
+	if !reflect.DeepEqual(dcb.ForwardedValues.Headers.Items, expandStringList(headersConf().List())) {
 		t.Fatalf("Expected Items to be %v, got %v", headersConf(), dcb.ForwardedValues.Headers.Items)
 	}
 	if *dcb.MinTTL != 0 {
@@ -368,10 +389,10 @@ func TestCloudFrontStructure_expandForwardedValues(t *testing.T) {
 	if !*fv.QueryString {
 		t.Fatalf("Expected QueryString to be true, got %v", *fv.QueryString)
 	}
-	if !reflect.DeepEqual(fv.Cookies.WhitelistedNames.Items, expandStringList(cookieNamesConf())) {
+	if !reflect.DeepEqual(fv.Cookies.WhitelistedNames.Items, expandStringList(cookieNamesConf().List())) {
 		t.Fatalf("Expected Cookies.WhitelistedNames.Items to be %v, got %v", cookieNamesConf(), fv.Cookies.WhitelistedNames.Items)
 	}
-	if !reflect.DeepEqual(fv.Headers.Items, expandStringList(headersConf())) {
+	if !reflect.DeepEqual(fv.Headers.Items, expandStringList(headersConf().List())) {
 		t.Fatalf("Expected Headers.Items to be %v, got %v", headersConf(), fv.Headers.Items)
 	}
 }
@@ -384,10 +405,15 @@ func TestCloudFrontStructure_flattenForwardedValues(t *testing.T) {
 	if !out["query_string"].(bool) {
 		t.Fatalf("Expected out[query_string] to be true, got %v", out["query_string"])
 	}
-	if !reflect.DeepEqual(out["cookies"], in["cookies"]) {
-		t.Fatalf("Expected out[cookies] to be %v, got %v", in["cookies"], out["cookies"])
+	inCookies := in["cookies"].([]interface{})[0].(map[string]interface{})
+	outCookies := out["cookies"].([]interface{})[0].(map[string]interface{})
+	if outCookies["forward"] != inCookies["forward"] {
+		t.Fatalf("Expected out[cookies][forward] to be %v, got %v", inCookies["forward"], outCookies["forward"])
+	}
+	if !outCookies["whitelisted_names"].(*schema.Set).Equal(inCookies["whitelisted_names"]) {
+		t.Fatalf("Expected out[cookies][whitelisted_names] to be %v, got %v", inCookies["whitelisted_names"], outCookies["whitelisted_names"])
 	}
-	if !reflect.DeepEqual(out["headers"], in["headers"]) {
+	if !out["headers"].(*schema.Set).Equal(in["headers"]) {
 		t.Fatalf("Expected out[headers] to be %v, got %v", in["headers"], out["headers"])
 	}
 }
@@ -398,7 +424,7 @@ func TestCloudFrontStructure_expandHeaders(t *testing.T) {
 	if *h.Quantity != 2 {
 		t.Fatalf("Expected Quantity to be 2, got %v", *h.Quantity)
 	}
-	if !reflect.DeepEqual(h.Items, expandStringList(data)) {
+	if !reflect.DeepEqual(h.Items, expandStringList(data.List())) {
 		t.Fatalf("Expected Items to be %v, got %v", data, h.Items)
 	}
 }
@@ -408,7 +434,7 @@ func TestCloudFrontStructure_flattenHeaders(t *testing.T) {
 	h := expandHeaders(in)
 	out := flattenHeaders(h)
 
-	if !reflect.DeepEqual(in, out) {
+	if !in.Equal(out) {
 		t.Fatalf("Expected out to be %v, got %v", in, out)
 	}
 }
@@ -440,7 +466,7 @@ func TestCloudFrontStructure_expandCookiePreference(t *testing.T) {
 	if *cp.Forward != "whitelist" {
 		t.Fatalf("Expected Forward to be whitelist, got %v", *cp.Forward)
 	}
-	if !reflect.DeepEqual(cp.WhitelistedNames.Items, expandStringList(cookieNamesConf())) {
+	if !reflect.DeepEqual(cp.WhitelistedNames.Items, expandStringList(cookieNamesConf().List())) {
 		t.Fatalf("Expected WhitelistedNames.Items to be %v, got %v", cookieNamesConf(), cp.WhitelistedNames.Items)
 	}
 }
@@ -450,8 +476,11 @@ func TestCloudFrontStructure_flattenCookiePreference(t *testing.T) {
 	cp := expandCookiePreference(in)
 	out := flattenCookiePreference(cp)
 
-	if !reflect.DeepEqual(in, out) {
-		t.Fatalf("Expected out to be %v, got %v", in, out)
+	if out["forward"] != in["forward"] {
+		t.Fatalf("Expected out[forward] to be %v, got %v", in["forward"], out["forward"])
+	}
+	if !out["whitelisted_names"].(*schema.Set).Equal(in["whitelisted_names"]) {
+		t.Fatalf("Expected out[whitelisted_names] to be %v, got %v", in["whitelisted_names"], out["whitelisted_names"])
 	}
 }
 
@@ -461,7 +490,7 @@ func TestCloudFrontStructure_expandCookieNames(t *testing.T) {
 	if *cn.Quantity != 2 {
 		t.Fatalf("Expected Quantity to be 2, got %v", *cn.Quantity)
 	}
-	if !reflect.DeepEqual(cn.Items, expandStringList(data)) {
+	if !reflect.DeepEqual(cn.Items, expandStringList(data.List())) {
 		t.Fatalf("Expected Items to be %v, got %v", data, cn.Items)
 	}
 }
@@ -471,7 +500,7 @@ func TestCloudFrontStructure_flattenCookieNames(t *testing.T) {
 	cn := expandCookieNames(in)
 	out := flattenCookieNames(cn)
 
-	if !reflect.DeepEqual(in, out) {
+	if !in.Equal(out) {
 		t.Fatalf("Expected out to be %v, got %v", in, out)
 	}
 }
@@ -560,6 +589,44 @@ func TestCloudFrontStructure_expandOrigin(t *testing.T) {
 	}
 }
 
+func TestCloudFrontStructure_expandOrigin_configs(t *testing.T) {
+	origin := func(s3OriginConfig, customOriginConfig []interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"origin_id":            "myOrigin",
+			"domain_name":          "www.example.com",
+			"s3_origin_config":     s3OriginConfig,
+			"custom_origin_config": customOriginConfig,
+		}
+	}
+
+	cases := []struct {
+		label                      string
+		origin                     map[string]interface{}
+		expectedOriginAccessId     *string
+		expectedCustomOriginConfig bool
+	}{
+		{"neither", origin(nil, nil), aws.String(""), false},
+		{"empty blocks", origin([]interface{}{nil}, []interface{}{nil}), aws.String(""), false},
+		{"s3_origin_config", origin([]interface{}{s3OriginConf()}, nil), aws.String("origin-access-identity/cloudfront/E127EXAMPLE51Z"), false},
+		{"custom_origin_config", origin(nil, []interface{}{customOriginConf()}), nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			or := expandOrigin(tc.origin)
+			if tc.expectedOriginAccessId == nil && or.S3OriginConfig != nil {
+				t.Fatalf("Expected no S3OriginConfig, got %v", or.S3OriginConfig)
+			}
+			if tc.expectedOriginAccessId != nil && (or.S3OriginConfig == nil || aws.StringValue(or.S3OriginConfig.OriginAccessIdentity) != *tc.expectedOriginAccessId) {
+				t.Fatalf("Expected S3OriginConfig with OriginAccessIdentity %q, got %v", *tc.expectedOriginAccessId, or.S3OriginConfig)
+			}
+			if tc.expectedCustomOriginConfig != (or.CustomOriginConfig != nil) {
+				t.Fatalf("Expected CustomOriginConfig to be set: %t, got %v", tc.expectedCustomOriginConfig, or.CustomOriginConfig)
+			}
+		})
+	}
+}
+
 func TestCloudFrontStructure_flattenOrigin(t *testing.T) {
 	in := originWithCustomConf()
 	or := expandOrigin(in)
@@ -576,6 +643,127 @@ func TestCloudFrontStructure_flattenOrigin(t *testing.T) {
 	}
 }
 
+func TestCloudFrontStructure_expandOriginGroups(t *testing.T) {
+	data := originGroupsConf()
+	ogs := expandOriginGroups(data)
+	if *ogs.Quantity != 1 {
+		t.Fatalf("Expected Quantity to be 1, got %v", *ogs.Quantity)
+	}
+	og := ogs.Items[0]
+	if *og.Id != "OriginGroup" {
+		t.Fatalf("Expected Id to be OriginGroup, got %v", *og.Id)
+	}
+	if *og.FailoverCriteria.StatusCodes.Quantity != 4 {
+		t.Fatalf("Expected FailoverCriteria.StatusCodes.Quantity to be 4, got %v", *og.FailoverCriteria.StatusCodes.Quantity)
+	}
+	if *og.Members.Quantity != 2 {
+		t.Fatalf("Expected Members.Quantity to be 2, got %v", *og.Members.Quantity)
+	}
+	if *og.Members.Items[0].OriginId != "CustomOrigin" {
+		t.Fatalf("Expected Members.Items[0].OriginId to be CustomOrigin, got %v", *og.Members.Items[0].OriginId)
+	}
+	if *og.Members.Items[1].OriginId != "S3Origin" {
+		t.Fatalf("Expected Members.Items[1].OriginId to be S3Origin, got %v", *og.Members.Items[1].OriginId)
+	}
+}
+
+func TestCloudFrontStructure_expandOriginGroups_empty(t *testing.T) {
+	ogs := expandOriginGroups(schema.NewSet(originGroupHash, []interface{}{}))
+	if *ogs.Quantity != 0 {
+		t.Fatalf("Expected Quantity to be 0, got %v", *ogs.Quantity)
+	}
+	if len(ogs.Items) != 0 {
+		t.Fatalf("Expected Items to be empty, got %v", ogs.Items)
+	}
+}
+
+func TestCloudFrontStructure_flattenOriginGroups(t *testing.T) {
+	in := originGroupsConf()
+	ogs := expandOriginGroups(in)
+	out := flattenOriginGroups(ogs)
+	diff := in.Difference(out)
+
+	if len(diff.List()) > 0 {
+		t.Fatalf("Expected out to be %v, got %v, diff: %v", in, out, diff)
+	}
+}
+
+func TestCloudFrontStructure_flattenOriginGroups_fullFailoverCriteria(t *testing.T) {
+	statusCodes := []interface{}{403, 404, 416, 500, 502, 503, 504}
+	in := originGroupConf()
+	in["failover_criteria"] = []interface{}{
+		map[string]interface{}{
+			"status_codes": schema.NewSet(schema.HashInt, statusCodes),
+		},
+	}
+
+	d := resourceAwsCloudFrontDistribution().Data(nil)
+	if err := d.Set("origin_group", flattenOriginGroups(expandOriginGroups(schema.NewSet(originGroupHash, []interface{}{in})))); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	out := d.Get("origin_group").(*schema.Set).List()
+	if len(out) != 1 {
+		t.Fatalf("Expected 1 origin_group, got %v", out)
+	}
+	failoverCriteria := out[0].(map[string]interface{})["failover_criteria"].([]interface{})
+	if len(failoverCriteria) != 1 {
+		t.Fatalf("Expected 1 failover_criteria, got %v", failoverCriteria)
+	}
+	var codes []int
+	for _, v := range failoverCriteria[0].(map[string]interface{})["status_codes"].(*schema.Set).List() {
+		codes = append(codes, v.(int))
+	}
+	sort.Ints(codes)
+	if expected := []int{403, 404, 416, 500, 502, 503, 504}; !reflect.DeepEqual(codes, expected) {
+		t.Fatalf("Expected status_codes to be %v, got %v", expected, codes)
+	}
+}
+
+func TestCloudFrontStructure_flattenOriginGroups_partial(t *testing.T) {
+	ogs := &cloudfront.OriginGroups{
+		Quantity: aws.Int64(3),
+		Items: []*cloudfront.OriginGroup{
+			nil,
+			{
+				Id:               aws.String("NoStatusCodes"),
+				FailoverCriteria: &cloudfront.OriginGroupFailoverCriteria{},
+				Members:          &cloudfront.OriginGroupMembers{Quantity: aws.Int64(1), Items: []*cloudfront.OriginGroupMember{nil}},
+			},
+			{
+				Id: aws.String("NilStatusCode"),
+				FailoverCriteria: &cloudfront.OriginGroupFailoverCriteria{
+					StatusCodes: &cloudfront.StatusCodes{Quantity: aws.Int64(2), Items: []*int64{aws.Int64(500), nil}},
+				},
+			},
+		},
+	}
+
+	out := flattenOriginGroups(ogs).List()
+	if len(out) != 2 {
+		t.Fatalf("Expected 2 origin groups, got %v", out)
+	}
+	for _, v := range out {
+		m := v.(map[string]interface{})
+		codes := m["failover_criteria"].([]interface{})[0].(map[string]interface{})["status_codes"].(*schema.Set)
+		switch m["origin_id"] {
+		case "NoStatusCodes":
+			if codes.Len() != 0 {
+				t.Fatalf("Expected no status_codes, got %v", codes.List())
+			}
+			if members := m["member"].([]interface{}); len(members) != 0 {
+				t.Fatalf("Expected no members, got %v", members)
+			}
+		case "NilStatusCode":
+			if !codes.Equal(schema.NewSet(schema.HashInt, []interface{}{500})) {
+				t.Fatalf("Expected status_codes to be [500], got %v", codes.List())
+			}
+		default:
+			t.Fatalf("Unexpected origin group %v", m)
+		}
+	}
+}
+
 func TestCloudFrontStructure_expandCustomHeaders(t *testing.T) {
 	in := originCustomHeadersConf()
 	chs := expandCustomHeaders(in)
@@ -819,6 +1007,281 @@ func TestCloudFrontStructure_flattenAliases(t *testing.T) {
 	}
 }
 
+func TestCloudFrontStructure_flattenDistributionConfig_effectiveAliases(t *testing.T) {
+	cases := []struct {
+		aliases  *cloudfront.Aliases
+		expected []interface{}
+	}{
+		{
+			aliases: &cloudfront.Aliases{
+				Quantity: aws.Int64(2),
+				Items:    aws.StringSlice([]string{"www.example.com", "static.example.com"}),
+			},
+			expected: []interface{}{"www.example.com", "static.example.com"},
+		},
+		{
+			aliases:  &cloudfront.Aliases{Quantity: aws.Int64(0)},
+			expected: []interface{}{},
+		},
+		{
+			aliases:  nil,
+			expected: []interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		d := resourceAwsCloudFrontDistribution().Data(nil)
+		distributionConfig := &cloudfront.DistributionConfig{
+			Aliases:              tc.aliases,
+			DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+			Enabled:              aws.Bool(true),
+			Origins:              expandOrigins(multiOriginConf()),
+			ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+		}
+		if err := flattenDistributionConfig(d, distributionConfig); err != nil {
+			t.Fatalf("Expected no error, received: %s", err)
+		}
+
+		if out := d.Get("effective_aliases").([]interface{}); !reflect.DeepEqual(out, tc.expected) {
+			t.Fatalf("Expected effective_aliases to be %v, got %v", tc.expected, out)
+		}
+	}
+}
+
+func TestCloudFrontStructure_flattenDistributionConfig_isIPV6Enabled(t *testing.T) {
+	cases := []struct {
+		isIPV6Enabled *bool
+		expected      bool
+	}{
+		{aws.Bool(true), true},
+		{aws.Bool(false), false},
+		{nil, false},
+	}
+
+	for _, tc := range cases {
+		d := resourceAwsCloudFrontDistribution().Data(nil)
+		distributionConfig := &cloudfront.DistributionConfig{
+			DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+			Enabled:              aws.Bool(true),
+			IsIPV6Enabled:        tc.isIPV6Enabled,
+			Origins:              expandOrigins(multiOriginConf()),
+			ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+		}
+		if err := flattenDistributionConfig(d, distributionConfig); err != nil {
+			t.Fatalf("Expected no error, received: %s", err)
+		}
+
+		if v, ok := d.GetOkExists("is_ipv6_enabled"); !ok || v.(bool) != tc.expected {
+			t.Fatalf("Expected is_ipv6_enabled to be set to %t, got %v (set: %t)", tc.expected, v, ok)
+		}
+	}
+}
+
+func TestCloudFrontStructure_flattenDistributionConfig_defaultRootObject(t *testing.T) {
+	cases := []struct {
+		defaultRootObject *string
+		expected          string
+	}{
+		{aws.String("index.html"), "index.html"},
+		{aws.String(""), ""},
+		{nil, ""},
+	}
+
+	for _, tc := range cases {
+		d := resourceAwsCloudFrontDistribution().Data(nil)
+		d.Set("default_root_object", "stale.html")
+		distributionConfig := &cloudfront.DistributionConfig{
+			DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+			DefaultRootObject:    tc.defaultRootObject,
+			Enabled:              aws.Bool(true),
+			Origins:              expandOrigins(multiOriginConf()),
+			ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+		}
+		if err := flattenDistributionConfig(d, distributionConfig); err != nil {
+			t.Fatalf("Expected no error, received: %s", err)
+		}
+
+		if v := d.Get("default_root_object").(string); v != tc.expected {
+			t.Fatalf("Expected default_root_object to be %q, got %q", tc.expected, v)
+		}
+	}
+}
+
+func TestCloudFrontStructure_flattenDistributionConfig_originGroupsRemoved(t *testing.T) {
+	cases := []struct {
+		label        string
+		originGroups *cloudfront.OriginGroups
+	}{
+		{"empty", &cloudfront.OriginGroups{Quantity: aws.Int64(0)}},
+		{"nil", nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			d := resourceAwsCloudFrontDistribution().Data(nil)
+			if err := d.Set("origin_group", originGroupsConf()); err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			distributionConfig := &cloudfront.DistributionConfig{
+				DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+				Enabled:              aws.Bool(true),
+				OriginGroups:         tc.originGroups,
+				Origins:              expandOrigins(multiOriginConf()),
+				ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+			}
+			if err := flattenDistributionConfig(d, distributionConfig); err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+
+			if v := d.Get("origin_group").(*schema.Set); v.Len() != 0 {
+				t.Fatalf("Expected no origin_group, got %v", v.List())
+			}
+		})
+	}
+}
+
+func TestCloudFrontStructure_flattenDistributionConfig_comment(t *testing.T) {
+	cases := []struct {
+		comment  *string
+		expected string
+	}{
+		{aws.String("Some comment"), "Some comment"},
+		{aws.String(""), ""},
+		{nil, ""},
+	}
+
+	for _, tc := range cases {
+		d := resourceAwsCloudFrontDistribution().Data(nil)
+		d.Set("comment", "stale comment")
+		distributionConfig := &cloudfront.DistributionConfig{
+			Comment:              tc.comment,
+			DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+			Enabled:              aws.Bool(true),
+			Origins:              expandOrigins(multiOriginConf()),
+			ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+		}
+		if err := flattenDistributionConfig(d, distributionConfig); err != nil {
+			t.Fatalf("Expected no error, received: %s", err)
+		}
+
+		if v := d.Get("comment").(string); v != tc.expected {
+			t.Fatalf("Expected comment to be %q, got %q", tc.expected, v)
+		}
+	}
+}
+
+func TestCloudFrontStructure_flattenDistributionConfig_attachedFunctions(t *testing.T) {
+	api := defaultCacheBehaviorConf()
+	api["path_pattern"] = "/api/*"
+	api["lambda_function_association"] = schema.NewSet(lambdaFunctionAssociationHash, []interface{}{
+		map[string]interface{}{
+			"event_type":   "origin-request",
+			"lambda_arn":   "arn:aws:lambda:us-east-1:999999999:function3:1",
+			"include_body": false,
+		},
+	})
+	static := defaultCacheBehaviorConf()
+	static["path_pattern"] = "/static/*"
+	static["lambda_function_association"] = schema.NewSet(lambdaFunctionAssociationHash, []interface{}{})
+
+	d := resourceAwsCloudFrontDistribution().Data(nil)
+	distributionConfig := &cloudfront.DistributionConfig{
+		CacheBehaviors:       expandCacheBehaviors([]interface{}{api, static}),
+		DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+		Enabled:              aws.Bool(true),
+		Origins:              expandOrigins(multiOriginConf()),
+		ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+	}
+	if err := flattenDistributionConfig(d, distributionConfig); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"arn":          "arn:aws:lambda:us-east-1:999999999:function1:alias",
+			"event_type":   "viewer-request",
+			"path_pattern": "*",
+		},
+		map[string]interface{}{
+			"arn":          "arn:aws:lambda:us-east-1:999999999:function2:alias",
+			"event_type":   "origin-response",
+			"path_pattern": "*",
+		},
+		map[string]interface{}{
+			"arn":          "arn:aws:lambda:us-east-1:999999999:function3:1",
+			"event_type":   "origin-request",
+			"path_pattern": "/api/*",
+		},
+	}
+	if v := d.Get("attached_functions").([]interface{}); !reflect.DeepEqual(v, expected) {
+		t.Fatalf("Expected attached_functions to be %v, got %v", expected, v)
+	}
+}
+
+func TestCloudFrontStructure_flattenDistributionConfig_httpVersion(t *testing.T) {
+	for _, httpVersion := range []string{"http1.1", "http2", "http2and3", "http3"} {
+		d := resourceAwsCloudFrontDistribution().Data(nil)
+		distributionConfig := &cloudfront.DistributionConfig{
+			DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+			Enabled:              aws.Bool(true),
+			HttpVersion:          aws.String(httpVersion),
+			Origins:              expandOrigins(multiOriginConf()),
+			ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+		}
+		if err := flattenDistributionConfig(d, distributionConfig); err != nil {
+			t.Fatalf("Expected no error, received: %s", err)
+		}
+
+		if v := d.Get("http_version").(string); v != httpVersion {
+			t.Fatalf("Expected http_version to be %q, got %q", httpVersion, v)
+		}
+		if v := aws.StringValue(expandDistributionConfig(d).HttpVersion); v != httpVersion {
+			t.Fatalf("Expected HttpVersion to be %q, got %q", httpVersion, v)
+		}
+	}
+}
+
+func TestCloudFrontStructure_flattenDistributionConfig_webAclArn(t *testing.T) {
+	webAclArn := "arn:aws:wafv2:us-east-1:123456789012:global/webacl/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+	webAclId := "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+
+	cases := []struct {
+		label         string
+		priorArn      string
+		webAclId      string
+		expectedArn   string
+		expectedAclId string
+	}{
+		{"web_acl_arn configured", webAclArn, webAclArn, webAclArn, ""},
+		{"web_acl_id configured with ARN", "", webAclArn, "", webAclArn},
+		{"web_acl_id configured with WAF Classic ID", "", webAclId, "", webAclId},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			d := resourceAwsCloudFrontDistribution().Data(nil)
+			d.Set("web_acl_arn", tc.priorArn)
+			distributionConfig := &cloudfront.DistributionConfig{
+				DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+				Enabled:              aws.Bool(true),
+				Origins:              expandOrigins(multiOriginConf()),
+				ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+				WebACLId:             aws.String(tc.webAclId),
+			}
+			if err := flattenDistributionConfig(d, distributionConfig); err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+
+			if v := d.Get("web_acl_arn").(string); v != tc.expectedArn {
+				t.Fatalf("Expected web_acl_arn to be %q, got %q", tc.expectedArn, v)
+			}
+			if v := d.Get("web_acl_id").(string); v != tc.expectedAclId {
+				t.Fatalf("Expected web_acl_id to be %q, got %q", tc.expectedAclId, v)
+			}
+		})
+	}
+}
+
 func TestCloudFrontStructure_expandRestrictions(t *testing.T) {
 	data := geoRestrictionsConf()
 	r := expandRestrictions(data)
@@ -940,3 +1403,268 @@ func TestCloudFrontStructure_expandViewerCertificate_acm_certificate_arn(t *test
 		t.Fatalf("Expected IAMCertificateId to be TLSv1, got %v", *vc.MinimumProtocolVersion)
 	}
 }
+
+func TestCloudFrontStructure_flattenViewerCertificate_certificateSource(t *testing.T) {
+	cases := []struct {
+		in       map[string]interface{}
+		expected string
+	}{
+		{viewerCertificateConfSetCloudFrontDefault(), cloudfront.CertificateSourceCloudfront},
+		{viewerCertificateConfSetIAM(), cloudfront.CertificateSourceIam},
+		{viewerCertificateConfSetACM(), cloudfront.CertificateSourceAcm},
+	}
+
+	for _, tc := range cases {
+		out := flattenViewerCertificate(expandViewerCertificate(tc.in))[0].(map[string]interface{})
+		if v := out["certificate_source"]; v != tc.expected {
+			t.Fatalf("Expected certificate_source to be %q, got %q", tc.expected, v)
+		}
+	}
+}
+
+func TestCloudFrontStructure_orderedCacheBehaviorsByPath(t *testing.T) {
+	behavior := func(pathPattern string, precedence int) map[string]interface{} {
+		m := defaultCacheBehaviorConf()
+		m["path_pattern"] = pathPattern
+		m["precedence"] = precedence
+		return m
+	}
+
+	cases := []struct {
+		label    string
+		config   []interface{}
+		expected []string
+	}{
+		{
+			"by precedence",
+			[]interface{}{behavior("/b/*", 2), behavior("/c/*", 0), behavior("/a/*", 1)},
+			[]string{"/c/*", "/a/*", "/b/*"},
+		},
+		{
+			"ties broken by path_pattern",
+			[]interface{}{behavior("/c/*", 1), behavior("/b/*", 1), behavior("/a/*", 5)},
+			[]string{"/b/*", "/c/*", "/a/*"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			// The result must not depend on the order the behaviors are configured in.
+			for i := 0; i < len(tc.config); i++ {
+				config := append(append([]interface{}{}, tc.config[i:]...), tc.config[:i]...)
+				d := resourceAwsCloudFrontDistribution().Data(nil)
+				if err := d.Set("ordered_cache_behavior_by_path", config); err != nil {
+					t.Fatalf("Expected no error, received: %s", err)
+				}
+
+				key, behaviors := cloudFrontDistributionOrderedCacheBehaviors(d)
+				if key != "ordered_cache_behavior_by_path" {
+					t.Fatalf("Expected ordered_cache_behavior_by_path, got %q", key)
+				}
+
+				cbs := expandCacheBehaviors(behaviors)
+				var pathPatterns []string
+				for _, cb := range cbs.Items {
+					pathPatterns = append(pathPatterns, aws.StringValue(cb.PathPattern))
+				}
+				if !reflect.DeepEqual(pathPatterns, tc.expected) {
+					t.Fatalf("Expected path patterns %v, got %v", tc.expected, pathPatterns)
+				}
+			}
+		})
+	}
+}
+
+func TestCloudFrontStructure_flattenCacheBehaviors_id(t *testing.T) {
+	cacheBehavior := func(pathPattern string) *cloudfront.CacheBehavior {
+		m := defaultCacheBehaviorConf()
+		m["path_pattern"] = pathPattern
+		return expandCacheBehavior(m)
+	}
+	ids := func(cbs *cloudfront.CacheBehaviors) map[string]string {
+		d := resourceAwsCloudFrontDistribution().Data(nil)
+		if err := d.Set("ordered_cache_behavior", flattenCacheBehaviors(cbs)); err != nil {
+			t.Fatalf("Expected no error, received: %s", err)
+		}
+
+		result := make(map[string]string)
+		for i := 0; i < len(cbs.Items); i++ {
+			id := d.Get(fmt.Sprintf("ordered_cache_behavior.%d.id", i)).(string)
+			pathPattern := d.Get(fmt.Sprintf("ordered_cache_behavior.%d.path_pattern", i)).(string)
+			if id != pathPattern {
+				t.Fatalf("Expected ordered_cache_behavior.%d.id to be %q, got %q", i, pathPattern, id)
+			}
+			result[id] = pathPattern
+		}
+		return result
+	}
+
+	ordered := ids(&cloudfront.CacheBehaviors{
+		Items: []*cloudfront.CacheBehavior{cacheBehavior("/a/*"), cacheBehavior("/b/*")},
+	})
+	reordered := ids(&cloudfront.CacheBehaviors{
+		Items: []*cloudfront.CacheBehavior{cacheBehavior("/b/*"), cacheBehavior("/a/*")},
+	})
+	if !reflect.DeepEqual(ordered, reordered) {
+		t.Fatalf("Expected IDs to be stable across reorders, got %v and %v", ordered, reordered)
+	}
+}
+
+func TestCloudFrontStructure_flattenCacheBehaviorsByPath(t *testing.T) {
+	cacheBehavior := func(pathPattern string) *cloudfront.CacheBehavior {
+		m := defaultCacheBehaviorConf()
+		m["path_pattern"] = pathPattern
+		return expandCacheBehavior(m)
+	}
+	prior := func(precedences map[string]int) *schema.Set {
+		d := resourceAwsCloudFrontDistribution().Data(nil)
+		var config []interface{}
+		for pathPattern, precedence := range precedences {
+			m := defaultCacheBehaviorConf()
+			m["path_pattern"] = pathPattern
+			m["precedence"] = precedence
+			config = append(config, m)
+		}
+		d.Set("ordered_cache_behavior_by_path", config)
+		return d.Get("ordered_cache_behavior_by_path").(*schema.Set)
+	}
+
+	cases := []struct {
+		label    string
+		prior    map[string]int
+		expected []int
+	}{
+		{"prior precedences kept", map[string]int{"/a/*": 10, "/b/*": 20, "/c/*": 20}, []int{10, 20, 20}},
+		{"new behavior", map[string]int{"/a/*": 10, "/c/*": 30}, []int{10, 11, 30}},
+		{"reordered outside of Terraform", map[string]int{"/a/*": 10, "/b/*": 5, "/c/*": 1}, []int{10, 11, 12}},
+	}
+
+	cbs := &cloudfront.CacheBehaviors{
+		Items: []*cloudfront.CacheBehavior{cacheBehavior("/a/*"), cacheBehavior("/b/*"), cacheBehavior("/c/*")},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			var precedences []int
+			for _, raw := range flattenCacheBehaviorsByPath(cbs, prior(tc.prior)) {
+				precedences = append(precedences, raw.(map[string]interface{})["precedence"].(int))
+			}
+			if !reflect.DeepEqual(precedences, tc.expected) {
+				t.Fatalf("Expected precedences %v, got %v", tc.expected, precedences)
+			}
+		})
+	}
+}
+
+func TestCloudFrontStructure_flattenDistributionConfig_partial(t *testing.T) {
+	minimal := func() *cloudfront.DistributionConfig {
+		return &cloudfront.DistributionConfig{
+			DefaultCacheBehavior: &cloudfront.DefaultCacheBehavior{},
+			Origins: &cloudfront.Origins{
+				Items: []*cloudfront.Origin{
+					{
+						Id:                 aws.String("myCustomOrigin"),
+						DomainName:         aws.String("www.example.com"),
+						CustomHeaders:      &cloudfront.CustomHeaders{Items: []*cloudfront.OriginCustomHeader{{}}},
+						CustomOriginConfig: &cloudfront.CustomOriginConfig{},
+					},
+					nil,
+				},
+			},
+			ViewerCertificate: &cloudfront.ViewerCertificate{},
+		}
+	}
+
+	cases := []struct {
+		label  string
+		config func() *cloudfront.DistributionConfig
+		err    string
+	}{
+		{
+			"nil configuration",
+			func() *cloudfront.DistributionConfig { return nil },
+			"CloudFront returned no distribution configuration",
+		},
+		{
+			"no default cache behavior",
+			func() *cloudfront.DistributionConfig {
+				c := minimal()
+				c.DefaultCacheBehavior = nil
+				return c
+			},
+			"CloudFront distribution configuration has no default_cache_behavior",
+		},
+		{
+			"no origins",
+			func() *cloudfront.DistributionConfig {
+				c := minimal()
+				c.Origins = nil
+				return c
+			},
+			"CloudFront distribution configuration has no origin",
+		},
+		{
+			"no viewer certificate",
+			func() *cloudfront.DistributionConfig {
+				c := minimal()
+				c.ViewerCertificate = nil
+				return c
+			},
+			"CloudFront distribution configuration has no viewer_certificate",
+		},
+		{
+			"minimal",
+			minimal,
+			"",
+		},
+		{
+			"partial nested structures",
+			func() *cloudfront.DistributionConfig {
+				c := minimal()
+				c.DefaultCacheBehavior.AllowedMethods = &cloudfront.AllowedMethods{}
+				c.CacheBehaviors = &cloudfront.CacheBehaviors{
+					Items: []*cloudfront.CacheBehavior{
+						{ForwardedValues: &cloudfront.ForwardedValues{Cookies: &cloudfront.CookiePreference{}}},
+						nil,
+					},
+				}
+				c.CustomErrorResponses = &cloudfront.CustomErrorResponses{Items: []*cloudfront.CustomErrorResponse{{}}}
+				c.Logging = &cloudfront.LoggingConfig{}
+				c.Restrictions = &cloudfront.Restrictions{}
+				c.OriginGroups = &cloudfront.OriginGroups{
+					Quantity: aws.Int64(1),
+					Items:    []*cloudfront.OriginGroup{{Id: aws.String("myOriginGroup")}},
+				}
+				return c
+			},
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			d := resourceAwsCloudFrontDistribution().Data(nil)
+			err := flattenDistributionConfig(d, tc.config())
+			if tc.err == "" && err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Fatalf("Expected error %q, received: %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestCloudFrontStructure_flattenActiveTrustedSigners_nil(t *testing.T) {
+	out := flattenActiveTrustedSigners(nil)
+	if v := out["enabled"]; v != "false" {
+		t.Fatalf("Expected enabled to be false, got %q", v)
+	}
+
+	out = flattenActiveTrustedSigners(&cloudfront.ActiveTrustedSigners{
+		Items: []*cloudfront.Signer{{AwsAccountNumber: aws.String("self")}},
+	})
+	if v := out["items.0.aws_account_number"]; v != "self" {
+		t.Fatalf("Expected aws_account_number to be self, got %q", v)
+	}
+}
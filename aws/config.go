@@ -179,6 +179,8 @@ type Config struct {
 	SkipRequestingAccountId bool
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
+
+	CloudFrontDefaultTags map[string]interface{}
 }
 
 type AWSClient struct {
@@ -198,6 +200,8 @@ type AWSClient struct {
 	cfconn                              *cloudformation.CloudFormation
 	cloud9conn                          *cloud9.Cloud9
 	cloudfrontconn                      *cloudfront.CloudFront
+	cloudfrontDistributionCache         *cloudFrontDistributionCache
+	cloudfrontDefaultTags               map[string]interface{}
 	cloudhsmv2conn                      *cloudhsmv2.CloudHSMV2
 	cloudsearchconn                     *cloudsearch.CloudSearch
 	cloudtrailconn                      *cloudtrail.CloudTrail
@@ -368,6 +372,8 @@ func (c *Config) Client() (interface{}, error) {
 		cfconn:                              cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudFormationEndpoint)})),
 		cloud9conn:                          cloud9.New(sess),
 		cloudfrontconn:                      cloudfront.New(sess),
+		cloudfrontDistributionCache:         newCloudFrontDistributionCache(),
+		cloudfrontDefaultTags:               c.CloudFrontDefaultTags,
 		cloudhsmv2conn:                      cloudhsmv2.New(sess),
 		cloudsearchconn:                     cloudsearch.New(sess),
 		cloudtrailconn:                      cloudtrail.New(sess),
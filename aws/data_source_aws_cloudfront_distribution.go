@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsCloudFrontDistribution() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudFrontDistributionRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"arn"},
+				ValidateFunc:  validation.NoZeroValues,
+			},
+			"arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"id"},
+				ValidateFunc:  validateArn,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hosted_zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"in_progress_validation_batches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	id := d.Get("id").(string)
+	if v, ok := d.GetOk("arn"); ok {
+		var err error
+		id, err = cloudFrontDistributionIdFromArn(v.(string))
+		if err != nil {
+			return err
+		}
+	}
+	if id == "" {
+		return fmt.Errorf("one of id or arn must be set")
+	}
+
+	resp, err := meta.(*AWSClient).cloudfrontDistributionCache.GetDistribution(conn, id)
+	if isAWSErr(err, cloudfront.ErrCodeNoSuchDistribution, "") {
+		return fmt.Errorf("CloudFront Distribution (%s) not found", id)
+	}
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Distribution (%s): %s", id, err)
+	}
+
+	distribution := resp.Distribution
+	if distribution == nil {
+		return fmt.Errorf("error reading CloudFront Distribution (%s): empty response", id)
+	}
+	if distribution.DistributionConfig == nil {
+		return fmt.Errorf("error reading CloudFront Distribution (%s): CloudFront returned no distribution configuration", id)
+	}
+
+	d.SetId(aws.StringValue(distribution.Id))
+	d.Set("arn", distribution.ARN)
+	d.Set("domain_name", distribution.DomainName)
+	d.Set("enabled", distribution.DistributionConfig.Enabled)
+	d.Set("etag", resp.ETag)
+	d.Set("hosted_zone_id", cloudFrontRoute53ZoneID)
+	d.Set("in_progress_validation_batches", distribution.InProgressInvalidationBatches)
+	d.Set("last_modified_time", aws.TimeValue(distribution.LastModifiedTime).String())
+	d.Set("status", distribution.Status)
+
+	tagResp, err := conn.ListTagsForResource(&cloudfront.ListTagsForResourceInput{
+		Resource: distribution.ARN,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing tags for CloudFront Distribution (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tagsToMapCloudFront(tagResp.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+// cloudFrontDistributionIdFromArn returns the distribution ID from an ARN of
+// the form arn:aws:cloudfront::123456789012:distribution/EDFDVBD632BHDS5.
+func cloudFrontDistributionIdFromArn(v string) (string, error) {
+	distributionArn, err := arn.Parse(v)
+	if err != nil {
+		return "", fmt.Errorf("error parsing CloudFront Distribution ARN (%s): %s", v, err)
+	}
+
+	if distributionArn.Service != "cloudfront" {
+		return "", fmt.Errorf("ARN (%s) is not a CloudFront ARN", v)
+	}
+
+	parts := strings.Split(distributionArn.Resource, "/")
+	if len(parts) != 2 || parts[0] != "distribution" || parts[1] == "" {
+		return "", fmt.Errorf("ARN (%s) is not a CloudFront Distribution ARN, expected resource of the form distribution/ID", v)
+	}
+
+	return parts[1], nil
+}
@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// cloudFrontDistributionConfigValidationKeys are the aws_cloudfront_distribution
+// arguments that make up a DistributionConfig.
+var cloudFrontDistributionConfigValidationKeys = []string{
+	"aliases",
+	"comment",
+	"custom_error_response",
+	"default_cache_behavior",
+	"default_root_object",
+	"enabled",
+	"http_version",
+	"is_ipv6_enabled",
+	"logging_config",
+	"ordered_cache_behavior",
+	"ordered_cache_behavior_by_path",
+	"origin",
+	"origin_group",
+	"price_class",
+	"restrictions",
+	"viewer_certificate",
+	"web_acl_arn",
+	"web_acl_id",
+}
+
+func dataSourceAwsCloudFrontDistributionConfigValidation() *schema.Resource {
+	distributionSchema := resourceAwsCloudFrontDistribution().Schema
+
+	s := map[string]*schema.Schema{
+		"valid": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"errors": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"distribution_config_json": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for _, k := range cloudFrontDistributionConfigValidationKeys {
+		s[k] = distributionSchema[k]
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceAwsCloudFrontDistributionConfigValidationRead,
+		Schema: s,
+	}
+}
+
+// dataSourceAwsCloudFrontDistributionConfigValidationRead builds the
+// DistributionConfig that aws_cloudfront_distribution would send and runs the
+// client-side checks against it. No CloudFront API calls are made.
+func dataSourceAwsCloudFrontDistributionConfigValidationRead(d *schema.ResourceData, meta interface{}) error {
+	var validationErrors []string
+	if v := d.Get("default_cache_behavior").([]interface{}); len(v) == 0 || v[0] == nil {
+		validationErrors = append(validationErrors, "default_cache_behavior: one block is required")
+	}
+
+	distributionConfig := expandDistributionConfig(d)
+	if err := distributionConfig.Validate(); err != nil {
+		if v, ok := err.(request.ErrInvalidParams); ok {
+			for _, e := range v.OrigErrs() {
+				validationErrors = append(validationErrors, e.Error())
+			}
+		} else {
+			validationErrors = append(validationErrors, err.Error())
+		}
+	}
+	for _, validation := range cloudFrontDistributionConfigValidations {
+		if err := validation.validate(d); err != nil {
+			validationErrors = append(validationErrors, err.Error())
+		}
+	}
+
+	// The caller reference is generated per request and would make the
+	// output differ on every read.
+	distributionConfig.CallerReference = nil
+
+	b, err := json.Marshal(distributionConfig)
+	if err != nil {
+		return fmt.Errorf("error marshaling CloudFront Distribution config: %s", err)
+	}
+	jsonString := string(b)
+
+	d.SetId(strconv.Itoa(hashcode.String(jsonString)))
+	d.Set("valid", len(validationErrors) == 0)
+	if err := d.Set("errors", validationErrors); err != nil {
+		return fmt.Errorf("error setting errors: %s", err)
+	}
+	d.Set("distribution_config_json", jsonString)
+
+	return nil
+}
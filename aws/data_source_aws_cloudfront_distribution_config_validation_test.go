@@ -0,0 +1,216 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDataSourceAwsCloudFrontDistributionConfigValidationRead(t *testing.T) {
+	origin := func(headerNames ...string) map[string]interface{} {
+		headers := make([]interface{}, 0, len(headerNames))
+		for i, name := range headerNames {
+			headers = append(headers, map[string]interface{}{"name": name, "value": fmt.Sprintf("value%d", i)})
+		}
+		return map[string]interface{}{
+			"origin_id":     "myS3Origin",
+			"domain_name":   "example.s3.amazonaws.com",
+			"custom_header": headers,
+		}
+	}
+
+	cases := []struct {
+		name        string
+		origin      map[string]interface{}
+		targetId    string
+		valid       bool
+		errorsRegex string
+	}{
+		{
+			name:     "valid",
+			origin:   origin("X-Header1"),
+			targetId: "myS3Origin",
+			valid:    true,
+		},
+		{
+			name:        "duplicate custom header",
+			origin:      origin("X-Header1", "x-header1"),
+			targetId:    "myS3Origin",
+			errorsRegex: `duplicate custom_header name`,
+		},
+		{
+			name:        "unknown target origin",
+			origin:      origin(),
+			targetId:    "missing",
+			errorsRegex: `target_origin_id "missing" does not match`,
+		},
+	}
+
+	for _, tc := range cases {
+		raw := map[string]interface{}{
+			"enabled": true,
+			"origin":  []interface{}{tc.origin},
+			"default_cache_behavior": []interface{}{
+				map[string]interface{}{
+					"allowed_methods":        []interface{}{"GET", "HEAD"},
+					"cached_methods":         []interface{}{"GET", "HEAD"},
+					"target_origin_id":       tc.targetId,
+					"viewer_protocol_policy": "allow-all",
+					"forwarded_values": []interface{}{
+						map[string]interface{}{
+							"query_string": false,
+							"cookies": []interface{}{
+								map[string]interface{}{"forward": "none"},
+							},
+						},
+					},
+				},
+			},
+			"restrictions": []interface{}{
+				map[string]interface{}{
+					"geo_restriction": []interface{}{
+						map[string]interface{}{"restriction_type": "none"},
+					},
+				},
+			},
+			"viewer_certificate": []interface{}{
+				map[string]interface{}{"cloudfront_default_certificate": true},
+			},
+		}
+
+		d := schema.TestResourceDataRaw(t, dataSourceAwsCloudFrontDistributionConfigValidation().Schema, raw)
+		if err := dataSourceAwsCloudFrontDistributionConfigValidationRead(d, nil); err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+
+		if got := d.Get("valid").(bool); got != tc.valid {
+			t.Fatalf("%s: expected valid %t, received %t (errors: %v)", tc.name, tc.valid, got, d.Get("errors"))
+		}
+		if d.Get("distribution_config_json").(string) == "" {
+			t.Fatalf("%s: expected distribution_config_json to be set", tc.name)
+		}
+		if tc.errorsRegex == "" {
+			continue
+		}
+		matched := false
+		for _, e := range d.Get("errors").([]interface{}) {
+			if regexp.MustCompile(tc.errorsRegex).MatchString(e.(string)) {
+				matched = true
+			}
+		}
+		if !matched {
+			t.Fatalf("%s: expected an error matching %q, received: %v", tc.name, tc.errorsRegex, d.Get("errors"))
+		}
+	}
+}
+
+func TestDataSourceAwsCloudFrontDistributionConfigValidationRead_noDefaultCacheBehavior(t *testing.T) {
+	raw := map[string]interface{}{
+		"enabled": true,
+		"origin": []interface{}{
+			map[string]interface{}{
+				"origin_id":   "myS3Origin",
+				"domain_name": "example.s3.amazonaws.com",
+			},
+		},
+		"restrictions": []interface{}{
+			map[string]interface{}{
+				"geo_restriction": []interface{}{
+					map[string]interface{}{"restriction_type": "none"},
+				},
+			},
+		},
+		"viewer_certificate": []interface{}{
+			map[string]interface{}{"cloudfront_default_certificate": true},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceAwsCloudFrontDistributionConfigValidation().Schema, raw)
+	if err := dataSourceAwsCloudFrontDistributionConfigValidationRead(d, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if d.Get("valid").(bool) {
+		t.Fatalf("expected the configuration to be invalid")
+	}
+	errors := d.Get("errors").([]interface{})
+	if len(errors) == 0 || errors[0].(string) != "default_cache_behavior: one block is required" {
+		t.Fatalf("expected a missing default_cache_behavior error, received: %v", errors)
+	}
+}
+
+func TestAccAWSCloudFrontDistributionConfigValidationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_distribution_config_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionConfigValidationDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "1"),
+					resource.TestMatchResourceAttr(dataSourceName, "errors.0", regexp.MustCompile(`duplicate custom_header name "x-example"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "distribution_config_json", regexp.MustCompile(`"OriginProtocolPolicy":"http-only"`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccAWSCloudFrontDistributionConfigValidationDataSourceConfig = `
+data "aws_cloudfront_distribution_config_validation" "test" {
+  enabled = true
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+
+    custom_header {
+      name  = "X-Example"
+      value = "one"
+    }
+
+    custom_header {
+      name  = "x-example"
+      value = "two"
+    }
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "myCustomOrigin"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "none"
+      }
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`
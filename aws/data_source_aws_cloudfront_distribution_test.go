@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestCloudFrontDistributionIdFromArn(t *testing.T) {
+	cases := []struct {
+		arn      string
+		id       string
+		errRegex string
+	}{
+		{
+			arn: "arn:aws:cloudfront::123456789012:distribution/EDFDVBD632BHDS5",
+			id:  "EDFDVBD632BHDS5",
+		},
+		{
+			arn:      "EDFDVBD632BHDS5",
+			errRegex: "error parsing CloudFront Distribution ARN",
+		},
+		{
+			arn:      "arn:aws:s3:::distribution/EDFDVBD632BHDS5",
+			errRegex: "is not a CloudFront ARN",
+		},
+		{
+			arn:      "arn:aws:cloudfront::123456789012:origin-access-identity/E127EXAMPLE51Z",
+			errRegex: "is not a CloudFront Distribution ARN",
+		},
+		{
+			arn:      "arn:aws:cloudfront::123456789012:distribution/",
+			errRegex: "is not a CloudFront Distribution ARN",
+		},
+	}
+
+	for _, tc := range cases {
+		id, err := cloudFrontDistributionIdFromArn(tc.arn)
+		if tc.errRegex == "" {
+			if err != nil {
+				t.Fatalf("%s: expected no error, received: %s", tc.arn, err)
+			}
+			if id != tc.id {
+				t.Fatalf("%s: expected ID %q, received: %q", tc.arn, tc.id, id)
+			}
+			continue
+		}
+		if err == nil || !regexp.MustCompile(tc.errRegex).MatchString(err.Error()) {
+			t.Fatalf("%s: expected error matching %q, received: %v", tc.arn, tc.errRegex, err)
+		}
+	}
+}
+
+func TestDataSourceAwsCloudFrontDistributionRead_emptyResponse(t *testing.T) {
+	cases := []struct {
+		label        string
+		distribution *cloudfront.Distribution
+		errRegex     string
+	}{
+		{
+			label:    "no distribution",
+			errRegex: "empty response",
+		},
+		{
+			label: "no distribution configuration",
+			distribution: &cloudfront.Distribution{
+				ARN: aws.String("arn:aws:cloudfront::123456789012:distribution/EDFDVBD632BHDS5"),
+				Id:  aws.String("EDFDVBD632BHDS5"),
+			},
+			errRegex: "CloudFront returned no distribution configuration",
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := cloudfront.New(sess)
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				if _, ok := r.Params.(*cloudfront.GetDistributionInput); ok {
+					r.Data.(*cloudfront.GetDistributionOutput).Distribution = tc.distribution
+				}
+			})
+
+			d := schema.TestResourceDataRaw(t, dataSourceAwsCloudFrontDistribution().Schema, map[string]interface{}{
+				"id": "EDFDVBD632BHDS5",
+			})
+
+			err := dataSourceAwsCloudFrontDistributionRead(d, &AWSClient{cloudfrontconn: conn})
+			if err == nil || !regexp.MustCompile(tc.errRegex).MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, received: %v", tc.errRegex, err)
+			}
+		})
+	}
+}
+
+func TestAccAWSCloudFrontDistributionDataSource_basic(t *testing.T) {
+	resourceName := "aws_cloudfront_distribution.no_optional_items"
+	dataSourceNameByID := "data.aws_cloudfront_distribution.by_id"
+	dataSourceNameByArn := "data.aws_cloudfront_distribution.by_arn"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "domain_name", resourceName, "domain_name"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "hosted_zone_id", resourceName, "hosted_zone_id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByID, "enabled", resourceName, "enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByArn, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameByArn, "domain_name", resourceName, "domain_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistributionDataSource_malformedArn(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionDataSourceConfigMalformedArn,
+				ExpectError: regexp.MustCompile(`is not a CloudFront Distribution ARN`),
+			},
+		},
+	})
+}
+
+var testAccAWSCloudFrontDistributionDataSourceConfig = fmt.Sprintf(`
+%s
+
+data "aws_cloudfront_distribution" "by_id" {
+  id = "${aws_cloudfront_distribution.no_optional_items.id}"
+}
+
+data "aws_cloudfront_distribution" "by_arn" {
+  arn = "${aws_cloudfront_distribution.no_optional_items.arn}"
+}
+`, testAccAWSCloudFrontDistributionNoOptionalItemsConfig)
+
+const testAccAWSCloudFrontDistributionDataSourceConfigMalformedArn = `
+data "aws_cloudfront_distribution" "test" {
+  arn = "arn:aws:cloudfront::123456789012:streaming-distribution/EDFDVBD632BHDS5"
+}
+`
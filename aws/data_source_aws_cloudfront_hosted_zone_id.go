@@ -0,0 +1,19 @@
+package aws
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsCloudFrontHostedZoneId() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudFrontHostedZoneIdRead,
+
+		Schema: map[string]*schema.Schema{},
+	}
+}
+
+func dataSourceAwsCloudFrontHostedZoneIdRead(d *schema.ResourceData, meta interface{}) error {
+	// All CloudFront distributions share the same Route 53 hosted zone
+	d.SetId(cloudFrontRoute53ZoneID)
+	return nil
+}
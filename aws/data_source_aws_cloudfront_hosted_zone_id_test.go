@@ -0,0 +1,26 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSCloudFrontHostedZoneId_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsCloudFrontHostedZoneIdConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_cloudfront_hosted_zone_id.main", "id", "Z2FDTNDATAQYW2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAwsCloudFrontHostedZoneIdConfig = `
+data "aws_cloudfront_hosted_zone_id" "main" { }
+`
@@ -1,6 +1,9 @@
 package aws
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,23 +13,81 @@ func resourceAwsCloudFrontDistributionImport(d *schema.ResourceData, meta interf
 	// This is a non API attribute
 	// We are merely setting this to the same value as the Default setting in the schema
 	d.Set("retain_on_delete", false)
+	d.Set("force_destroy", false)
+	d.Set("skip_disable_wait", false)
+	d.Set("ignore_tagging_access_denied", false)
 
 	conn := meta.(*AWSClient).cloudfrontconn
-	id := d.Id()
-	resp, err := conn.GetDistributionConfig(&cloudfront.GetDistributionConfigInput{
-		Id: aws.String(id),
-	})
 
+	// Accept the distribution's *.cloudfront.net domain name in place of its ID
+	if strings.HasSuffix(strings.ToLower(d.Id()), ".cloudfront.net") {
+		id, err := resourceAwsCloudFrontDistributionIdByDomainName(conn, d.Id())
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(id)
+	}
+
+	id := d.Id()
+	resp, err := meta.(*AWSClient).cloudfrontDistributionCache.GetDistribution(conn, id)
 	if err != nil {
 		return nil, err
 	}
 
-	distConfig := resp.DistributionConfig
+	if resp.Distribution == nil {
+		return nil, fmt.Errorf("error importing CloudFront Distribution (%s): empty response", id)
+	}
+
+	distConfig := resp.Distribution.DistributionConfig
 	results := make([]*schema.ResourceData, 1)
 	err = flattenDistributionConfig(d, distConfig)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error importing CloudFront Distribution (%s): %s", id, err)
+	}
+
+	// Populate tags so the imported state matches the configuration
+	// without waiting on the first refresh
+	tagResp, err := conn.ListTagsForResource(&cloudfront.ListTagsForResourceInput{
+		Resource: resp.Distribution.ARN,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags for CloudFront Distribution (%s): %s", id, err)
 	}
+	tagsAll := tagsToMapCloudFront(tagResp.Tags)
+	tags := tagsWithoutDefaultsCloudFront(meta.(*AWSClient).cloudfrontDefaultTags, tagsAll, nil)
+	if err := d.Set("tags", tags); err != nil {
+		return nil, fmt.Errorf("error setting tags: %s", err)
+	}
+	if err := d.Set("tags_all", tagsAll); err != nil {
+		return nil, fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	results[0] = d
 	return results, nil
 }
+
+// resourceAwsCloudFrontDistributionIdByDomainName returns the ID of the
+// distribution with the given *.cloudfront.net domain name.
+func resourceAwsCloudFrontDistributionIdByDomainName(conn *cloudfront.CloudFront, domainName string) (string, error) {
+	var id string
+
+	input := &cloudfront.ListDistributionsInput{}
+	err := conn.ListDistributionsPages(input, func(page *cloudfront.ListDistributionsOutput, lastPage bool) bool {
+		for _, distributionSummary := range page.DistributionList.Items {
+			if strings.EqualFold(aws.StringValue(distributionSummary.DomainName), domainName) {
+				id = aws.StringValue(distributionSummary.Id)
+				return false
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", fmt.Errorf("error listing CloudFront Distributions: %s", err)
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("no CloudFront Distribution found with domain name %q", domainName)
+	}
+
+	return id, nil
+}
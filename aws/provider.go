@@ -141,128 +141,138 @@ func Provider() terraform.ResourceProvider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"cloudfront_default_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["cloudfront_default_tags"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate":                    dataSourceAwsAcmCertificate(),
-			"aws_acmpca_certificate_authority":       dataSourceAwsAcmpcaCertificateAuthority(),
-			"aws_ami":                                dataSourceAwsAmi(),
-			"aws_ami_ids":                            dataSourceAwsAmiIds(),
-			"aws_api_gateway_api_key":                dataSourceAwsApiGatewayApiKey(),
-			"aws_api_gateway_resource":               dataSourceAwsApiGatewayResource(),
-			"aws_api_gateway_rest_api":               dataSourceAwsApiGatewayRestApi(),
-			"aws_api_gateway_vpc_link":               dataSourceAwsApiGatewayVpcLink(),
-			"aws_arn":                                dataSourceAwsArn(),
-			"aws_autoscaling_group":                  dataSourceAwsAutoscalingGroup(),
-			"aws_autoscaling_groups":                 dataSourceAwsAutoscalingGroups(),
-			"aws_availability_zone":                  dataSourceAwsAvailabilityZone(),
-			"aws_availability_zones":                 dataSourceAwsAvailabilityZones(),
-			"aws_batch_compute_environment":          dataSourceAwsBatchComputeEnvironment(),
-			"aws_batch_job_queue":                    dataSourceAwsBatchJobQueue(),
-			"aws_billing_service_account":            dataSourceAwsBillingServiceAccount(),
-			"aws_caller_identity":                    dataSourceAwsCallerIdentity(),
-			"aws_canonical_user_id":                  dataSourceAwsCanonicalUserId(),
-			"aws_cloudformation_export":              dataSourceAwsCloudFormationExport(),
-			"aws_cloudformation_stack":               dataSourceAwsCloudFormationStack(),
-			"aws_cloudhsm_v2_cluster":                dataSourceCloudHsm2Cluster(),
-			"aws_cloudtrail_service_account":         dataSourceAwsCloudTrailServiceAccount(),
-			"aws_cloudwatch_log_group":               dataSourceAwsCloudwatchLogGroup(),
-			"aws_cognito_user_pools":                 dataSourceAwsCognitoUserPools(),
-			"aws_codecommit_repository":              dataSourceAwsCodeCommitRepository(),
-			"aws_cur_report_definition":              dataSourceAwsCurReportDefinition(),
-			"aws_db_cluster_snapshot":                dataSourceAwsDbClusterSnapshot(),
-			"aws_db_event_categories":                dataSourceAwsDbEventCategories(),
-			"aws_db_instance":                        dataSourceAwsDbInstance(),
-			"aws_db_snapshot":                        dataSourceAwsDbSnapshot(),
-			"aws_dx_gateway":                         dataSourceAwsDxGateway(),
-			"aws_dynamodb_table":                     dataSourceAwsDynamoDbTable(),
-			"aws_ebs_snapshot":                       dataSourceAwsEbsSnapshot(),
-			"aws_ebs_snapshot_ids":                   dataSourceAwsEbsSnapshotIds(),
-			"aws_ebs_volume":                         dataSourceAwsEbsVolume(),
-			"aws_ec2_transit_gateway":                dataSourceAwsEc2TransitGateway(),
-			"aws_ec2_transit_gateway_route_table":    dataSourceAwsEc2TransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_vpc_attachment": dataSourceAwsEc2TransitGatewayVpcAttachment(),
-			"aws_ecr_repository":                     dataSourceAwsEcrRepository(),
-			"aws_ecs_cluster":                        dataSourceAwsEcsCluster(),
-			"aws_ecs_container_definition":           dataSourceAwsEcsContainerDefinition(),
-			"aws_ecs_service":                        dataSourceAwsEcsService(),
-			"aws_ecs_task_definition":                dataSourceAwsEcsTaskDefinition(),
-			"aws_efs_file_system":                    dataSourceAwsEfsFileSystem(),
-			"aws_efs_mount_target":                   dataSourceAwsEfsMountTarget(),
-			"aws_eip":                                dataSourceAwsEip(),
-			"aws_eks_cluster":                        dataSourceAwsEksCluster(),
-			"aws_eks_cluster_auth":                   dataSourceAwsEksClusterAuth(),
-			"aws_elastic_beanstalk_application":      dataSourceAwsElasticBeanstalkApplication(),
-			"aws_elastic_beanstalk_hosted_zone":      dataSourceAwsElasticBeanstalkHostedZone(),
-			"aws_elastic_beanstalk_solution_stack":   dataSourceAwsElasticBeanstalkSolutionStack(),
-			"aws_elasticache_cluster":                dataSourceAwsElastiCacheCluster(),
-			"aws_elb":                                dataSourceAwsElb(),
-			"aws_elasticache_replication_group":      dataSourceAwsElasticacheReplicationGroup(),
-			"aws_elb_hosted_zone_id":                 dataSourceAwsElbHostedZoneId(),
-			"aws_elb_service_account":                dataSourceAwsElbServiceAccount(),
-			"aws_glue_script":                        dataSourceAwsGlueScript(),
-			"aws_iam_account_alias":                  dataSourceAwsIamAccountAlias(),
-			"aws_iam_group":                          dataSourceAwsIAMGroup(),
-			"aws_iam_instance_profile":               dataSourceAwsIAMInstanceProfile(),
-			"aws_iam_policy":                         dataSourceAwsIAMPolicy(),
-			"aws_iam_policy_document":                dataSourceAwsIamPolicyDocument(),
-			"aws_iam_role":                           dataSourceAwsIAMRole(),
-			"aws_iam_server_certificate":             dataSourceAwsIAMServerCertificate(),
-			"aws_iam_user":                           dataSourceAwsIAMUser(),
-			"aws_internet_gateway":                   dataSourceAwsInternetGateway(),
-			"aws_iot_endpoint":                       dataSourceAwsIotEndpoint(),
-			"aws_inspector_rules_packages":           dataSourceAwsInspectorRulesPackages(),
-			"aws_instance":                           dataSourceAwsInstance(),
-			"aws_instances":                          dataSourceAwsInstances(),
-			"aws_ip_ranges":                          dataSourceAwsIPRanges(),
-			"aws_kinesis_stream":                     dataSourceAwsKinesisStream(),
-			"aws_kms_alias":                          dataSourceAwsKmsAlias(),
-			"aws_kms_ciphertext":                     dataSourceAwsKmsCiphertext(),
-			"aws_kms_key":                            dataSourceAwsKmsKey(),
-			"aws_kms_secret":                         dataSourceAwsKmsSecret(),
-			"aws_kms_secrets":                        dataSourceAwsKmsSecrets(),
-			"aws_lambda_function":                    dataSourceAwsLambdaFunction(),
-			"aws_lambda_invocation":                  dataSourceAwsLambdaInvocation(),
-			"aws_launch_configuration":               dataSourceAwsLaunchConfiguration(),
-			"aws_launch_template":                    dataSourceAwsLaunchTemplate(),
-			"aws_mq_broker":                          dataSourceAwsMqBroker(),
-			"aws_nat_gateway":                        dataSourceAwsNatGateway(),
-			"aws_network_acls":                       dataSourceAwsNetworkAcls(),
-			"aws_network_interface":                  dataSourceAwsNetworkInterface(),
-			"aws_network_interfaces":                 dataSourceAwsNetworkInterfaces(),
-			"aws_partition":                          dataSourceAwsPartition(),
-			"aws_prefix_list":                        dataSourceAwsPrefixList(),
-			"aws_pricing_product":                    dataSourceAwsPricingProduct(),
-			"aws_rds_cluster":                        dataSourceAwsRdsCluster(),
-			"aws_redshift_cluster":                   dataSourceAwsRedshiftCluster(),
-			"aws_redshift_service_account":           dataSourceAwsRedshiftServiceAccount(),
-			"aws_region":                             dataSourceAwsRegion(),
-			"aws_route":                              dataSourceAwsRoute(),
-			"aws_route_table":                        dataSourceAwsRouteTable(),
-			"aws_route_tables":                       dataSourceAwsRouteTables(),
-			"aws_route53_delegation_set":             dataSourceAwsDelegationSet(),
-			"aws_route53_zone":                       dataSourceAwsRoute53Zone(),
-			"aws_s3_bucket":                          dataSourceAwsS3Bucket(),
-			"aws_s3_bucket_object":                   dataSourceAwsS3BucketObject(),
-			"aws_secretsmanager_secret":              dataSourceAwsSecretsManagerSecret(),
-			"aws_secretsmanager_secret_version":      dataSourceAwsSecretsManagerSecretVersion(),
-			"aws_sns_topic":                          dataSourceAwsSnsTopic(),
-			"aws_sqs_queue":                          dataSourceAwsSqsQueue(),
-			"aws_ssm_document":                       dataSourceAwsSsmDocument(),
-			"aws_ssm_parameter":                      dataSourceAwsSsmParameter(),
-			"aws_storagegateway_local_disk":          dataSourceAwsStorageGatewayLocalDisk(),
-			"aws_subnet":                             dataSourceAwsSubnet(),
-			"aws_subnet_ids":                         dataSourceAwsSubnetIDs(),
-			"aws_vpcs":                               dataSourceAwsVpcs(),
-			"aws_security_group":                     dataSourceAwsSecurityGroup(),
-			"aws_security_groups":                    dataSourceAwsSecurityGroups(),
-			"aws_vpc":                                dataSourceAwsVpc(),
-			"aws_vpc_dhcp_options":                   dataSourceAwsVpcDhcpOptions(),
-			"aws_vpc_endpoint":                       dataSourceAwsVpcEndpoint(),
-			"aws_vpc_endpoint_service":               dataSourceAwsVpcEndpointService(),
-			"aws_vpc_peering_connection":             dataSourceAwsVpcPeeringConnection(),
-			"aws_vpn_gateway":                        dataSourceAwsVpnGateway(),
-			"aws_workspaces_bundle":                  dataSourceAwsWorkspaceBundle(),
+			"aws_acm_certificate":                           dataSourceAwsAcmCertificate(),
+			"aws_acmpca_certificate_authority":              dataSourceAwsAcmpcaCertificateAuthority(),
+			"aws_ami":                                       dataSourceAwsAmi(),
+			"aws_ami_ids":                                   dataSourceAwsAmiIds(),
+			"aws_api_gateway_api_key":                       dataSourceAwsApiGatewayApiKey(),
+			"aws_api_gateway_resource":                      dataSourceAwsApiGatewayResource(),
+			"aws_api_gateway_rest_api":                      dataSourceAwsApiGatewayRestApi(),
+			"aws_api_gateway_vpc_link":                      dataSourceAwsApiGatewayVpcLink(),
+			"aws_arn":                                       dataSourceAwsArn(),
+			"aws_autoscaling_group":                         dataSourceAwsAutoscalingGroup(),
+			"aws_autoscaling_groups":                        dataSourceAwsAutoscalingGroups(),
+			"aws_availability_zone":                         dataSourceAwsAvailabilityZone(),
+			"aws_availability_zones":                        dataSourceAwsAvailabilityZones(),
+			"aws_batch_compute_environment":                 dataSourceAwsBatchComputeEnvironment(),
+			"aws_batch_job_queue":                           dataSourceAwsBatchJobQueue(),
+			"aws_billing_service_account":                   dataSourceAwsBillingServiceAccount(),
+			"aws_caller_identity":                           dataSourceAwsCallerIdentity(),
+			"aws_canonical_user_id":                         dataSourceAwsCanonicalUserId(),
+			"aws_cloudformation_export":                     dataSourceAwsCloudFormationExport(),
+			"aws_cloudformation_stack":                      dataSourceAwsCloudFormationStack(),
+			"aws_cloudfront_distribution":                   dataSourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_distribution_config_validation": dataSourceAwsCloudFrontDistributionConfigValidation(),
+			"aws_cloudfront_hosted_zone_id":                 dataSourceAwsCloudFrontHostedZoneId(),
+			"aws_cloudhsm_v2_cluster":                       dataSourceCloudHsm2Cluster(),
+			"aws_cloudtrail_service_account":                dataSourceAwsCloudTrailServiceAccount(),
+			"aws_cloudwatch_log_group":                      dataSourceAwsCloudwatchLogGroup(),
+			"aws_cognito_user_pools":                        dataSourceAwsCognitoUserPools(),
+			"aws_codecommit_repository":                     dataSourceAwsCodeCommitRepository(),
+			"aws_cur_report_definition":                     dataSourceAwsCurReportDefinition(),
+			"aws_db_cluster_snapshot":                       dataSourceAwsDbClusterSnapshot(),
+			"aws_db_event_categories":                       dataSourceAwsDbEventCategories(),
+			"aws_db_instance":                               dataSourceAwsDbInstance(),
+			"aws_db_snapshot":                               dataSourceAwsDbSnapshot(),
+			"aws_dx_gateway":                                dataSourceAwsDxGateway(),
+			"aws_dynamodb_table":                            dataSourceAwsDynamoDbTable(),
+			"aws_ebs_snapshot":                              dataSourceAwsEbsSnapshot(),
+			"aws_ebs_snapshot_ids":                          dataSourceAwsEbsSnapshotIds(),
+			"aws_ebs_volume":                                dataSourceAwsEbsVolume(),
+			"aws_ec2_transit_gateway":                       dataSourceAwsEc2TransitGateway(),
+			"aws_ec2_transit_gateway_route_table":           dataSourceAwsEc2TransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_vpc_attachment":        dataSourceAwsEc2TransitGatewayVpcAttachment(),
+			"aws_ecr_repository":                            dataSourceAwsEcrRepository(),
+			"aws_ecs_cluster":                               dataSourceAwsEcsCluster(),
+			"aws_ecs_container_definition":                  dataSourceAwsEcsContainerDefinition(),
+			"aws_ecs_service":                               dataSourceAwsEcsService(),
+			"aws_ecs_task_definition":                       dataSourceAwsEcsTaskDefinition(),
+			"aws_efs_file_system":                           dataSourceAwsEfsFileSystem(),
+			"aws_efs_mount_target":                          dataSourceAwsEfsMountTarget(),
+			"aws_eip":                                       dataSourceAwsEip(),
+			"aws_eks_cluster":                               dataSourceAwsEksCluster(),
+			"aws_eks_cluster_auth":                          dataSourceAwsEksClusterAuth(),
+			"aws_elastic_beanstalk_application":             dataSourceAwsElasticBeanstalkApplication(),
+			"aws_elastic_beanstalk_hosted_zone":             dataSourceAwsElasticBeanstalkHostedZone(),
+			"aws_elastic_beanstalk_solution_stack":          dataSourceAwsElasticBeanstalkSolutionStack(),
+			"aws_elasticache_cluster":                       dataSourceAwsElastiCacheCluster(),
+			"aws_elb":                                       dataSourceAwsElb(),
+			"aws_elasticache_replication_group":             dataSourceAwsElasticacheReplicationGroup(),
+			"aws_elb_hosted_zone_id":                        dataSourceAwsElbHostedZoneId(),
+			"aws_elb_service_account":                       dataSourceAwsElbServiceAccount(),
+			"aws_glue_script":                               dataSourceAwsGlueScript(),
+			"aws_iam_account_alias":                         dataSourceAwsIamAccountAlias(),
+			"aws_iam_group":                                 dataSourceAwsIAMGroup(),
+			"aws_iam_instance_profile":                      dataSourceAwsIAMInstanceProfile(),
+			"aws_iam_policy":                                dataSourceAwsIAMPolicy(),
+			"aws_iam_policy_document":                       dataSourceAwsIamPolicyDocument(),
+			"aws_iam_role":                                  dataSourceAwsIAMRole(),
+			"aws_iam_server_certificate":                    dataSourceAwsIAMServerCertificate(),
+			"aws_iam_user":                                  dataSourceAwsIAMUser(),
+			"aws_internet_gateway":                          dataSourceAwsInternetGateway(),
+			"aws_iot_endpoint":                              dataSourceAwsIotEndpoint(),
+			"aws_inspector_rules_packages":                  dataSourceAwsInspectorRulesPackages(),
+			"aws_instance":                                  dataSourceAwsInstance(),
+			"aws_instances":                                 dataSourceAwsInstances(),
+			"aws_ip_ranges":                                 dataSourceAwsIPRanges(),
+			"aws_kinesis_stream":                            dataSourceAwsKinesisStream(),
+			"aws_kms_alias":                                 dataSourceAwsKmsAlias(),
+			"aws_kms_ciphertext":                            dataSourceAwsKmsCiphertext(),
+			"aws_kms_key":                                   dataSourceAwsKmsKey(),
+			"aws_kms_secret":                                dataSourceAwsKmsSecret(),
+			"aws_kms_secrets":                               dataSourceAwsKmsSecrets(),
+			"aws_lambda_function":                           dataSourceAwsLambdaFunction(),
+			"aws_lambda_invocation":                         dataSourceAwsLambdaInvocation(),
+			"aws_launch_configuration":                      dataSourceAwsLaunchConfiguration(),
+			"aws_launch_template":                           dataSourceAwsLaunchTemplate(),
+			"aws_mq_broker":                                 dataSourceAwsMqBroker(),
+			"aws_nat_gateway":                               dataSourceAwsNatGateway(),
+			"aws_network_acls":                              dataSourceAwsNetworkAcls(),
+			"aws_network_interface":                         dataSourceAwsNetworkInterface(),
+			"aws_network_interfaces":                        dataSourceAwsNetworkInterfaces(),
+			"aws_partition":                                 dataSourceAwsPartition(),
+			"aws_prefix_list":                               dataSourceAwsPrefixList(),
+			"aws_pricing_product":                           dataSourceAwsPricingProduct(),
+			"aws_rds_cluster":                               dataSourceAwsRdsCluster(),
+			"aws_redshift_cluster":                          dataSourceAwsRedshiftCluster(),
+			"aws_redshift_service_account":                  dataSourceAwsRedshiftServiceAccount(),
+			"aws_region":                                    dataSourceAwsRegion(),
+			"aws_route":                                     dataSourceAwsRoute(),
+			"aws_route_table":                               dataSourceAwsRouteTable(),
+			"aws_route_tables":                              dataSourceAwsRouteTables(),
+			"aws_route53_delegation_set":                    dataSourceAwsDelegationSet(),
+			"aws_route53_zone":                              dataSourceAwsRoute53Zone(),
+			"aws_s3_bucket":                                 dataSourceAwsS3Bucket(),
+			"aws_s3_bucket_object":                          dataSourceAwsS3BucketObject(),
+			"aws_secretsmanager_secret":                     dataSourceAwsSecretsManagerSecret(),
+			"aws_secretsmanager_secret_version":             dataSourceAwsSecretsManagerSecretVersion(),
+			"aws_sns_topic":                                 dataSourceAwsSnsTopic(),
+			"aws_sqs_queue":                                 dataSourceAwsSqsQueue(),
+			"aws_ssm_document":                              dataSourceAwsSsmDocument(),
+			"aws_ssm_parameter":                             dataSourceAwsSsmParameter(),
+			"aws_storagegateway_local_disk":                 dataSourceAwsStorageGatewayLocalDisk(),
+			"aws_subnet":                                    dataSourceAwsSubnet(),
+			"aws_subnet_ids":                                dataSourceAwsSubnetIDs(),
+			"aws_vpcs":                                      dataSourceAwsVpcs(),
+			"aws_security_group":                            dataSourceAwsSecurityGroup(),
+			"aws_security_groups":                           dataSourceAwsSecurityGroups(),
+			"aws_vpc":                                       dataSourceAwsVpc(),
+			"aws_vpc_dhcp_options":                          dataSourceAwsVpcDhcpOptions(),
+			"aws_vpc_endpoint":                              dataSourceAwsVpcEndpoint(),
+			"aws_vpc_endpoint_service":                      dataSourceAwsVpcEndpointService(),
+			"aws_vpc_peering_connection":                    dataSourceAwsVpcPeeringConnection(),
+			"aws_vpn_gateway":                               dataSourceAwsVpnGateway(),
+			"aws_workspaces_bundle":                         dataSourceAwsWorkspaceBundle(),
 
 			// Adding the Aliases for the ALB -> LB Rename
 			"aws_lb":               dataSourceAwsLb(),
@@ -861,6 +871,9 @@ func init() {
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
 
+		"cloudfront_default_tags": "Tags added to every CloudFront distribution, unless the distribution's\n" +
+			"tags set the same key. They are not shown in the distribution's tags.",
+
 		"assume_role_role_arn": "The ARN of an IAM role to assume prior to making API calls.",
 
 		"assume_role_session_name": "The session name to use when assuming the role. If omitted," +
@@ -890,6 +903,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
+		CloudFrontDefaultTags:   d.Get("cloudfront_default_tags").(map[string]interface{}),
 	}
 
 	// Set CredsFilename, expanding home directory
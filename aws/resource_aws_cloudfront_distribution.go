@@ -1,13 +1,21 @@
 package aws
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -23,6 +31,28 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 			State: resourceAwsCloudFrontDistributionImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(70 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceAwsCloudFrontDistributionCustomizeDiffConfig,
+			resourceAwsCloudFrontDistributionCustomizeDiffAliasesCertificate,
+			resourceAwsCloudFrontDistributionCustomizeDiffAliasesAdded,
+			resourceAwsCloudFrontDistributionCustomizeDiffAliasesLimit,
+			resourceAwsCloudFrontDistributionCustomizeDiffS3OriginHostHeader,
+			resourceAwsCloudFrontDistributionCustomizeDiffHttpOnlyOrigins,
+			resourceAwsCloudFrontDistributionCustomizeDiffTrustedSigners,
+			resourceAwsCloudFrontDistributionCustomizeDiffPriceClass,
+			resourceAwsCloudFrontDistributionCustomizeDiffTagsAll,
+			customdiff.ComputedIf("effective_aliases", func(diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("aliases")
+			}),
+			customdiff.ComputedIf("summary_json", func(diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("aliases")
+			}),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -31,8 +61,11 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 			"aliases": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      aliasesHash,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCloudFrontDistributionAlias,
+				},
+				Set: aliasesHash,
 			},
 			"cache_behavior": {
 				Type:     schema.TypeSet,
@@ -56,9 +89,10 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Default:  false,
 						},
 						"default_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  86400,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      86400,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 						"field_level_encryption_id": {
 							Type:     schema.TypeString,
@@ -116,8 +150,9 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 										Required: true,
 									},
 									"lambda_arn": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateCloudFrontLambdaFunctionArn,
 									},
 									"include_body": {
 										Type:     schema.TypeBool,
@@ -128,14 +163,16 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							},
 						},
 						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      31536000,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 						"min_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  0,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 						"path_pattern": {
 							Type:     schema.TypeString,
@@ -162,130 +199,19 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				},
 			},
 			"ordered_cache_behavior": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"ordered_cache_behavior_by_path"},
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"allowed_methods": {
-							Type:     schema.TypeSet,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"cached_methods": {
-							Type:     schema.TypeSet,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"compress": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-						"default_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  86400,
-						},
-						"field_level_encryption_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"forwarded_values": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"cookies": {
-										Type:     schema.TypeList,
-										Required: true,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"forward": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"whitelisted_names": {
-													Type:     schema.TypeList,
-													Optional: true,
-													Elem:     &schema.Schema{Type: schema.TypeString},
-												},
-											},
-										},
-									},
-									"headers": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"query_string": {
-										Type:     schema.TypeBool,
-										Required: true,
-									},
-									"query_string_cache_keys": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-								},
-							},
-						},
-						"lambda_function_association": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							MaxItems: 4,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"event_type": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"lambda_arn": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"include_body": {
-										Type:     schema.TypeBool,
-										Optional: true,
-										Default:  false,
-									},
-								},
-							},
-							Set: lambdaFunctionAssociationHash,
-						},
-						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
-						},
-						"min_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  0,
-						},
-						"path_pattern": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"smooth_streaming": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-						"target_origin_id": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"trusted_signers": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"viewer_protocol_policy": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
+					Schema: resourceAwsCloudFrontDistributionOrderedCacheBehaviorSchema(),
+				},
+			},
+			"ordered_cache_behavior_by_path": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"ordered_cache_behavior"},
+				Elem: &schema.Resource{
+					Schema: resourceAwsCloudFrontDistributionOrderedCacheBehaviorByPathSchema(),
 				},
 			},
 			"comment": {
@@ -299,12 +225,14 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"error_caching_min_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 31536000),
 						},
 						"error_code": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateCloudFrontCustomErrorResponseErrorCode,
 						},
 						"response_code": {
 							Type:     schema.TypeInt,
@@ -339,9 +267,10 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Default:  false,
 						},
 						"default_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  86400,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      86400,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 						"field_level_encryption_id": {
 							Type:     schema.TypeString,
@@ -364,7 +293,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 													Required: true,
 												},
 												"whitelisted_names": {
-													Type:     schema.TypeList,
+													Type:     schema.TypeSet,
 													Optional: true,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
@@ -372,7 +301,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 										},
 									},
 									"headers": {
-										Type:     schema.TypeList,
+										Type:     schema.TypeSet,
 										Optional: true,
 										Elem:     &schema.Schema{Type: schema.TypeString},
 									},
@@ -399,8 +328,9 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 										Required: true,
 									},
 									"lambda_arn": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateCloudFrontLambdaFunctionArn,
 									},
 									"include_body": {
 										Type:     schema.TypeBool,
@@ -412,22 +342,26 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Set: lambdaFunctionAssociationHash,
 						},
 						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      31536000,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 						"min_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  0,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 						"smooth_streaming": {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
 						"target_origin_id": {
-							Type:     schema.TypeString,
-							Required: true,
+							// Defaults to the origin_id of the only origin
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressCloudFrontDistributionInferredTargetOriginId,
 						},
 						"trusted_signers": {
 							Type:     schema.TypeList,
@@ -442,8 +376,9 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				},
 			},
 			"default_root_object": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCloudFrontDistributionDefaultRootObject,
 			},
 			"enabled": {
 				Type:     schema.TypeBool,
@@ -453,7 +388,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "http2",
-				ValidateFunc: validation.StringInSlice([]string{"http1.1", "http2"}, false),
+				ValidateFunc: validateCloudFrontDistributionHttpVersion,
 			},
 			"logging_config": {
 				Type:     schema.TypeList,
@@ -462,8 +397,9 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"bucket": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCloudFrontDistributionLoggingBucket,
 						},
 						"include_cookies": {
 							Type:     schema.TypeBool,
@@ -499,14 +435,16 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 										Required: true,
 									},
 									"origin_keepalive_timeout": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  5,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      5,
+										ValidateFunc: validation.IntBetween(1, 60),
 									},
 									"origin_read_timeout": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										Default:  30,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      30,
+										ValidateFunc: validateCloudFrontOriginReadTimeout,
 									},
 									"origin_protocol_policy": {
 										Type:     schema.TypeString,
@@ -515,7 +453,10 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 									"origin_ssl_protocols": {
 										Type:     schema.TypeSet,
 										Required: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateCloudFrontOriginSslProtocol,
+										},
 									},
 								},
 							},
@@ -567,6 +508,52 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 					},
 				},
 			},
+			"origin_group": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      originGroupHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"origin_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"failover_criteria": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status_codes": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeInt,
+											ValidateFunc: validateCloudFrontOriginGroupFailoverStatusCode,
+										},
+									},
+								},
+							},
+						},
+						"member": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 2,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"origin_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"price_class": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -592,6 +579,11 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 									"restriction_type": {
 										Type:     schema.TypeString,
 										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											cloudfront.GeoRestrictionTypeNone,
+											cloudfront.GeoRestrictionTypeWhitelist,
+											cloudfront.GeoRestrictionTypeBlacklist,
+										}, false),
 									},
 								},
 							},
@@ -610,6 +602,10 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Optional:      true,
 							ConflictsWith: []string{"viewer_certificate.0.cloudfront_default_certificate", "viewer_certificate.0.iam_certificate_id"},
 						},
+						"certificate_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"cloudfront_default_certificate": {
 							Type:          schema.TypeBool,
 							Optional:      true,
@@ -626,15 +622,23 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Default:  "TLSv1",
 						},
 						"ssl_support_method": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateCloudFrontDistributionSslSupportMethod,
 						},
 					},
 				},
 			},
 			"web_acl_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"web_acl_arn"},
+			},
+			"web_acl_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"web_acl_id"},
+				ValidateFunc:  validateCloudFrontWebAclArn,
 			},
 			"caller_reference": {
 				Type:     schema.TypeString,
@@ -652,6 +656,35 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"effective_aliases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"summary_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attached_functions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path_pattern": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"last_modified_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -676,6 +709,28 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"delete_after": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"retain_on_delete"},
+				ValidateFunc:  validateCloudFrontDistributionDeleteAfter,
+			},
+			"skip_disable_wait": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"retain_on_delete"},
+			},
+			"ignore_tagging_access_denied": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"is_ipv6_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -683,64 +738,281 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 			},
 
 			"tags": tagsSchema(),
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
-func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
-
-	params := &cloudfront.CreateDistributionWithTagsInput{
-		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
-			DistributionConfig: expandDistributionConfig(d),
-			Tags:               tagsFromMapCloudFront(d.Get("tags").(map[string]interface{})),
+// resourceAwsCloudFrontDistributionOrderedCacheBehaviorSchema returns the
+// schema of an ordered cache behavior, shared by ordered_cache_behavior and
+// ordered_cache_behavior_by_path.
+func resourceAwsCloudFrontDistributionOrderedCacheBehaviorSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"allowed_methods": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
-	}
-
-	var resp *cloudfront.CreateDistributionWithTagsOutput
-	// Handle eventual consistency issues
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = conn.CreateDistributionWithTags(params)
+		"cached_methods": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"compress": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"default_ttl": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      86400,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"field_level_encryption_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"forwarded_values": {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cookies": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"forward": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"whitelisted_names": {
+									Type:     schema.TypeSet,
+									Optional: true,
+									Elem:     &schema.Schema{Type: schema.TypeString},
+								},
+							},
+						},
+					},
+					"headers": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"query_string": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"query_string_cache_keys": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"lambda_function_association": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 4,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"event_type": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"lambda_arn": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateCloudFrontLambdaFunctionArn,
+					},
+					"include_body": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+			Set: lambdaFunctionAssociationHash,
+		},
+		"max_ttl": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      31536000,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"min_ttl": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		// id identifies the behavior independently of its position, for
+		// building maps keyed by behavior. path_pattern is unique within a
+		// distribution, so it doubles as the ID.
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"path_pattern": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"smooth_streaming": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"target_origin_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"trusted_signers": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"viewer_protocol_policy": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+}
+
+// resourceAwsCloudFrontDistributionOrderedCacheBehaviorByPathSchema returns
+// the schema of an ordered_cache_behavior_by_path block, which orders cache
+// behaviors by precedence instead of by position.
+func resourceAwsCloudFrontDistributionOrderedCacheBehaviorByPathSchema() map[string]*schema.Schema {
+	s := resourceAwsCloudFrontDistributionOrderedCacheBehaviorSchema()
+	s["precedence"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+
+	return s
+}
+
+func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	if err := validateCloudFrontDistributionDefaultTargetOriginId(d); err != nil {
+		return err
+	}
+
+	params := &cloudfront.CreateDistributionWithTagsInput{
+		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
+			DistributionConfig: expandDistributionConfig(d),
+			Tags:               tagsFromMapCloudFront(tagsWithDefaultsCloudFront(meta.(*AWSClient).cloudfrontDefaultTags, d.Get("tags").(map[string]interface{}))),
+		},
+	}
+
+	resp, err := resourceAwsCloudFrontDistributionCreateDistribution(conn, params)
+	// Creating a distribution with tags also requires cloudfront:TagResource
+	if isAWSErr(err, cloudfront.ErrCodeAccessDenied, "") && len(params.DistributionConfigWithTags.Tags.Items) > 0 {
+		if !d.Get("ignore_tagging_access_denied").(bool) {
+			return fmt.Errorf("error creating CloudFront Distribution: creating a distribution with tags requires both the cloudfront:CreateDistribution and cloudfront:TagResource permissions, remove tags or set ignore_tagging_access_denied to create it without them: %s", err)
+		}
+
+		log.Printf("[WARN] Access denied creating CloudFront Distribution with tags, creating it without tags: %s", err)
+		params.DistributionConfigWithTags.Tags = &cloudfront.Tags{Items: []*cloudfront.Tag{}}
+		resp, err = resourceAwsCloudFrontDistributionCreateDistribution(conn, params)
+	}
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Distribution: %s", cloudFrontDistributionViewerCertificateError(err))
+	}
+
+	d.SetId(*resp.Distribution.Id)
+	// Available to dependent resources even if the following read fails
+	d.Set("arn", resp.Distribution.ARN)
+	d.Set("etag", resp.ETag)
+
+	return resourceAwsCloudFrontDistributionRead(d, meta)
+}
+
+// resourceAwsCloudFrontDistributionGetDistribution reads the distribution
+// through the cache. When retryNotFound is set, NoSuchDistribution is retried
+// for up to a minute to ride out eventual consistency, e.g. right after
+// creation. The last error is returned if it persists.
+func resourceAwsCloudFrontDistributionGetDistribution(conn *cloudfront.CloudFront, cache *cloudFrontDistributionCache, id string, retryNotFound bool) (*cloudfront.GetDistributionOutput, error) {
+	if !retryNotFound {
+		return cache.GetDistribution(conn, id)
+	}
+
+	var output *cloudfront.GetDistributionOutput
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = cache.GetDistribution(conn, id)
+		if isAWSErr(err, cloudfront.ErrCodeNoSuchDistribution, "") {
+			log.Printf("[DEBUG] CloudFront Distribution (%s) not found yet, retrying", id)
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	return output, err
+}
+
+// resourceAwsCloudFrontDistributionCreateDistribution creates the
+// distribution, retrying on eventual consistency errors. Every attempt sends
+// the same input and so the same CallerReference, which CloudFront uses to
+// recognize a repeated request instead of creating another distribution.
+func resourceAwsCloudFrontDistributionCreateDistribution(conn *cloudfront.CloudFront, input *cloudfront.CreateDistributionWithTagsInput) (*cloudfront.CreateDistributionWithTagsOutput, error) {
+	var output *cloudfront.CreateDistributionWithTagsOutput
+	// Handle eventual consistency issues
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateDistributionWithTags(input)
 		if err != nil {
 			// ACM and IAM certificate eventual consistency
 			// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
 			if isAWSErr(err, cloudfront.ErrCodeInvalidViewerCertificate, "") {
 				return resource.RetryableError(err)
 			}
+			// Origin access identity eventual consistency
+			// InvalidArgument: The specified origin access identity does not exist or is not valid.
+			if isAWSErr(err, cloudfront.ErrCodeNoSuchCloudFrontOriginAccessIdentity, "") || isAWSErr(err, cloudfront.ErrCodeInvalidArgument, "origin access identity") {
+				return resource.RetryableError(err)
+			}
 			return resource.NonRetryableError(err)
 		}
 		return nil
 	})
-	if err != nil {
-		return fmt.Errorf("error creating CloudFront Distribution: %s", err)
-	}
 
-	d.SetId(*resp.Distribution.Id)
-	return resourceAwsCloudFrontDistributionRead(d, meta)
+	return output, err
 }
 
 func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudfrontconn
-	params := &cloudfront.GetDistributionInput{
-		Id: aws.String(d.Id()),
-	}
 
-	resp, err := conn.GetDistribution(params)
+	// A distribution that was only just created may not be visible yet
+	resp, err := resourceAwsCloudFrontDistributionGetDistribution(conn, meta.(*AWSClient).cloudfrontDistributionCache, d.Id(), d.IsNewResource())
+	if isAWSErr(err, cloudfront.ErrCodeNoSuchDistribution, "") {
+		log.Printf("[WARN] CloudFront Distribution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		if errcode, ok := err.(awserr.Error); ok && errcode.Code() == "NoSuchDistribution" {
-			log.Printf("[WARN] No Distribution found: %s", d.Id())
-			d.SetId("")
-			return nil
-		}
-
 		return err
 	}
 
+	if resp.Distribution == nil {
+		return fmt.Errorf("error reading CloudFront Distribution (%s): empty response", d.Id())
+	}
+
 	// Update attributes from DistributionConfig
 	err = flattenDistributionConfig(d, resp.Distribution.DistributionConfig)
 	if err != nil {
-		return err
+		return fmt.Errorf("error reading CloudFront Distribution (%s): %s", d.Id(), err)
 	}
 	// Update other attributes outside of DistributionConfig
 	err = d.Set("active_trusted_signers", flattenActiveTrustedSigners(resp.Distribution.ActiveTrustedSigners))
@@ -749,82 +1021,317 @@ func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interfac
 	}
 	d.Set("status", resp.Distribution.Status)
 	d.Set("domain_name", resp.Distribution.DomainName)
-	d.Set("last_modified_time", aws.String(resp.Distribution.LastModifiedTime.String()))
+	if resp.Distribution.LastModifiedTime != nil {
+		d.Set("last_modified_time", aws.String(resp.Distribution.LastModifiedTime.String()))
+	}
 	d.Set("in_progress_validation_batches", resp.Distribution.InProgressInvalidationBatches)
 	d.Set("etag", resp.ETag)
 	d.Set("arn", resp.Distribution.ARN)
 
+	summaryJson, err := cloudFrontDistributionSummaryJson(resp.Distribution)
+	if err != nil {
+		return fmt.Errorf("error building CloudFront Distribution (%s) summary: %s", d.Id(), err)
+	}
+	d.Set("summary_json", summaryJson)
+
 	tagResp, err := conn.ListTagsForResource(&cloudfront.ListTagsForResourceInput{
 		Resource: aws.String(d.Get("arn").(string)),
 	})
 
+	// The distribution was deleted between the two calls
+	if isAWSErr(err, cloudfront.ErrCodeNoSuchResource, "") {
+		log.Printf("[WARN] CloudFront Distribution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if isAWSErr(err, cloudfront.ErrCodeAccessDenied, "") && d.Get("ignore_tagging_access_denied").(bool) {
+		log.Printf("[WARN] Access denied listing tags for CloudFront Distribution (%s), keeping tags from state: %s", d.Id(), err)
+		return nil
+	}
+	if isAWSErr(err, cloudfront.ErrCodeAccessDenied, "") {
+		return fmt.Errorf(
+			"Error retrieving tags for CloudFront Distribution %q (ARN: %q): the cloudfront:ListTagsForResource permission is required: %s",
+			d.Id(), d.Get("arn").(string), err)
+	}
 	if err != nil {
 		return fmt.Errorf(
-			"Error retrieving EC2 tags for CloudFront Distribution %q (ARN: %q): %s",
+			"Error retrieving tags for CloudFront Distribution %q (ARN: %q): %s",
 			d.Id(), d.Get("arn").(string), err)
 	}
 
-	if err := d.Set("tags", tagsToMapCloudFront(tagResp.Tags)); err != nil {
+	// All tags are read back, so tags added or removed outside of Terraform
+	// show up as drift on the next plan. The provider's default tags are
+	// left out unless the configuration sets them too, and only show up in
+	// tags_all.
+	tagsAll := tagsToMapCloudFront(tagResp.Tags)
+	tags := tagsWithoutDefaultsCloudFront(meta.(*AWSClient).cloudfrontDefaultTags, tagsAll, d.Get("tags").(map[string]interface{}))
+	if err := d.Set("tags", tags); err != nil {
+		return err
+	}
+	if err := d.Set("tags_all", tagsAll); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// cloudFrontDistributionSummary is the content of the summary_json attribute.
+// Fields are in alphabetical order and aliases are sorted, so the JSON only
+// changes when the distribution does.
+type cloudFrontDistributionSummary struct {
+	Aliases    []string `json:"aliases"`
+	Arn        string   `json:"arn"`
+	DomainName string   `json:"domain_name"`
+	Status     string   `json:"status"`
+}
+
+func cloudFrontDistributionSummaryJson(distribution *cloudfront.Distribution) (string, error) {
+	summary := cloudFrontDistributionSummary{
+		Aliases:    []string{},
+		Arn:        aws.StringValue(distribution.ARN),
+		DomainName: aws.StringValue(distribution.DomainName),
+		Status:     aws.StringValue(distribution.Status),
+	}
+	if distribution.DistributionConfig != nil && distribution.DistributionConfig.Aliases != nil {
+		summary.Aliases = append(summary.Aliases, aws.StringValueSlice(distribution.DistributionConfig.Aliases.Items)...)
+	}
+	sort.Strings(summary.Aliases)
+
+	b, err := json.Marshal(summary)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
 func resourceAwsCloudFrontDistributionUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudfrontconn
-	params := &cloudfront.UpdateDistributionInput{
-		Id:                 aws.String(d.Id()),
-		DistributionConfig: expandDistributionConfig(d),
-		IfMatch:            aws.String(d.Get("etag").(string)),
-	}
 
-	// Handle eventual consistency issues
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.UpdateDistribution(params)
+	// Tags are managed outside of the DistributionConfig, so a tags-only
+	// change does not need to send (and redeploy) the whole configuration.
+	// force_destroy, skip_disable_wait, retain_on_delete and delete_after
+	// only affect Terraform's delete behavior, and
+	// ignore_tagging_access_denied only how tagging errors are handled.
+	if resourceAwsCloudFrontDistributionHasChangesExcept(d, "tags", "tags_all", "force_destroy", "skip_disable_wait", "retain_on_delete", "delete_after", "ignore_tagging_access_denied") {
+		if err := validateCloudFrontDistributionDefaultTargetOriginId(d); err != nil {
+			return err
+		}
+
+		params := &cloudfront.UpdateDistributionInput{
+			Id:                 aws.String(d.Id()),
+			DistributionConfig: expandDistributionConfig(d),
+			IfMatch:            aws.String(d.Get("etag").(string)),
+		}
+
+		err := resourceAwsCloudFrontDistributionUpdateDistribution(conn, params)
+		meta.(*AWSClient).cloudfrontDistributionCache.Invalidate(d.Id())
 		if err != nil {
-			// ACM and IAM certificate eventual consistency
-			// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
-			if isAWSErr(err, cloudfront.ErrCodeInvalidViewerCertificate, "") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return fmt.Errorf("error updating CloudFront Distribution (%s): %s", d.Id(), cloudFrontDistributionViewerCertificateError(err))
 		}
-		return nil
-	})
-	if err != nil {
-		return fmt.Errorf("error updating CloudFront Distribution (%s): %s", d.Id(), err)
 	}
 
-	if err := setTagsCloudFront(conn, d, d.Get("arn").(string)); err != nil {
+	err := setTagsCloudFront(conn, d, d.Get("arn").(string), meta.(*AWSClient).cloudfrontDefaultTags)
+	if isAWSErr(err, cloudfront.ErrCodeAccessDenied, "") {
+		if !d.Get("ignore_tagging_access_denied").(bool) {
+			return fmt.Errorf("error updating tags for CloudFront Distribution (%s): the cloudfront:TagResource and cloudfront:UntagResource permissions are required to manage tags: %s", d.Id(), err)
+		}
+		log.Printf("[WARN] Access denied updating tags for CloudFront Distribution (%s), tags were not changed: %s", d.Id(), err)
+	} else if err != nil {
 		return err
 	}
 
 	return resourceAwsCloudFrontDistributionRead(d, meta)
 }
 
+// cloudFrontDistributionViewerCertificateError adds the usual causes to an
+// InvalidViewerCertificate error, which CloudFront returns without saying
+// what is wrong with the certificate. Other errors are returned unchanged.
+func cloudFrontDistributionViewerCertificateError(err error) error {
+	if !isAWSErr(err, cloudfront.ErrCodeInvalidViewerCertificate, "") {
+		return err
+	}
+
+	return fmt.Errorf("the viewer certificate was rejected. Check that an ACM certificate is in us-east-1 and has been issued, that an IAM certificate was uploaded with the /cloudfront/ path, and that the certificate covers every alias: %s", err)
+}
+
+// resourceAwsCloudFrontDistributionUpdateDistribution sends the distribution
+// configuration update. If the distribution was modified since its ETag was
+// last read, the current ETag is fetched and the update is retried once.
+func resourceAwsCloudFrontDistributionUpdateDistribution(conn *cloudfront.CloudFront, input *cloudfront.UpdateDistributionInput) error {
+	update := func() error {
+		// Handle eventual consistency issues
+		return resource.Retry(1*time.Minute, func() *resource.RetryError {
+			_, err := conn.UpdateDistribution(input)
+			if err != nil {
+				// ACM and IAM certificate eventual consistency
+				// InvalidViewerCertificate: The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.
+				if isAWSErr(err, cloudfront.ErrCodeInvalidViewerCertificate, "") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+	}
+
+	err := update()
+	if isAWSErr(err, cloudfront.ErrCodePreconditionFailed, "") || isAWSErr(err, cloudfront.ErrCodeInvalidIfMatchVersion, "") {
+		log.Printf("[DEBUG] CloudFront Distribution (%s) ETag %q is stale, refreshing: %s", aws.StringValue(input.Id), aws.StringValue(input.IfMatch), err)
+
+		output, getErr := conn.GetDistribution(&cloudfront.GetDistributionInput{
+			Id: input.Id,
+		})
+		if getErr != nil {
+			return fmt.Errorf("error refreshing ETag: %s", getErr)
+		}
+
+		input.IfMatch = output.ETag
+		err = update()
+	}
+
+	return err
+}
+
+// resourceAwsCloudFrontDistributionHasChangesExcept reports whether any
+// attribute of the distribution, other than the given keys, has changed.
+func resourceAwsCloudFrontDistributionHasChangesExcept(d *schema.ResourceData, keys ...string) bool {
+	ignored := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		ignored[k] = true
+	}
+
+	for k := range resourceAwsCloudFrontDistribution().Schema {
+		if ignored[k] {
+			continue
+		}
+		// HasChange compares values with reflect.DeepEqual, which never
+		// considers two blocks containing sets equal.
+		if o, n := d.GetChange(k); !cloudFrontDistributionValuesEqual(o, n) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cloudFrontDistributionValuesEqual reports whether two attribute values are
+// equal, comparing sets at any depth by their contents.
+func cloudFrontDistributionValuesEqual(a, b interface{}) bool {
+	switch a := a.(type) {
+	case *schema.Set:
+		// Set.Equal has the same problem with sets nested in its elements.
+		// List orders elements by hash code, so equal sets line up.
+		b, ok := b.(*schema.Set)
+		return ok && cloudFrontDistributionValuesEqual(a.List(), b.List())
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !cloudFrontDistributionValuesEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			if !cloudFrontDistributionValuesEqual(v, b[k]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
 func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudfrontconn
+	// The delete timeout covers the whole destroy, so each wait below only
+	// gets the time that is left.
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
 
 	// manually disable the distribution first
-	d.Set("enabled", false)
-	err := resourceAwsCloudFrontDistributionUpdate(d, meta)
-	if err != nil {
-		return err
+	if d.Get("force_destroy").(bool) {
+		// Disable based on the live configuration rather than the state,
+		// which may not reflect changes made outside of this resource
+		err := resourceAwsCloudFrontDistributionDisable(conn, d.Id())
+		meta.(*AWSClient).cloudfrontDistributionCache.Invalidate(d.Id())
+		if err != nil {
+			return fmt.Errorf("error disabling CloudFront Distribution (%s): %s", d.Id(), err)
+		}
+		if err := resourceAwsCloudFrontDistributionRead(d, meta); err != nil {
+			return err
+		}
+		if d.Id() == "" {
+			return nil
+		}
+	} else {
+		// Send the update here rather than through Update, which only sends
+		// one when the configuration differs from the state. Setting enabled
+		// does not count as a change.
+		d.Set("enabled", false)
+		err := resourceAwsCloudFrontDistributionUpdateDistribution(conn, &cloudfront.UpdateDistributionInput{
+			Id:                 aws.String(d.Id()),
+			DistributionConfig: expandDistributionConfig(d),
+			IfMatch:            aws.String(d.Get("etag").(string)),
+		})
+		meta.(*AWSClient).cloudfrontDistributionCache.Invalidate(d.Id())
+		if err != nil {
+			return fmt.Errorf("error disabling CloudFront Distribution (%s): %s", d.Id(), err)
+		}
+		// Refresh the ETag for the delete below
+		if err := resourceAwsCloudFrontDistributionRead(d, meta); err != nil {
+			return err
+		}
+		if d.Id() == "" {
+			return nil
+		}
 	}
 
+	disabledAt := time.Now()
+
 	// skip delete if retain_on_delete is enabled
 	if d.Get("retain_on_delete").(bool) {
 		log.Printf("[WARN] Removing CloudFront Distribution ID %q with `retain_on_delete` set. Please delete this distribution manually.", d.Id())
 		return nil
 	}
 
-	// Distribution needs to be in deployed state again before it can be deleted.
-	err = resourceAwsCloudFrontDistributionWaitUntilDeployed(d.Id(), meta)
-	if err != nil {
-		return err
+	// Terraform cannot schedule a delete for later, so a delete_after window
+	// is only honored if it ends before the delete timeout does. A window
+	// that cannot fit is skipped right away instead of after the wait below.
+	var deleteAfter time.Duration
+	if v, ok := d.GetOk("delete_after"); ok {
+		deleteAfter, _ = time.ParseDuration(v.(string))
+	}
+	if resourceAwsCloudFrontDistributionDeleteAfterExceedsDeadline(d.Id(), disabledAt, deleteAfter, deadline) {
+		return nil
+	}
+
+	// Distribution needs to be in deployed state again before it can be
+	// deleted. With skip_disable_wait the delete below is retried instead,
+	// which notices the end of the deployment sooner than this waiter does.
+	if !d.Get("skip_disable_wait").(bool) {
+		err := resourceAwsCloudFrontDistributionWaitUntilDeployed(d.Id(), meta, time.Until(deadline))
+		if err != nil {
+			return fmt.Errorf("error waiting for CloudFront Distribution (%s) to be disabled: %s", d.Id(), err)
+		}
+	}
+
+	// The wait can end after the delete_after window would have, which
+	// leaves no time to delete the distribution
+	if resourceAwsCloudFrontDistributionDeleteAfterExceedsDeadline(d.Id(), disabledAt, deleteAfter, deadline) {
+		return nil
 	}
 
+	resourceAwsCloudFrontDistributionWaitForDeleteAfter(d.Id(), disabledAt, deleteAfter)
+
 	// now delete
 	params := &cloudfront.DeleteDistributionInput{
 		Id:      aws.String(d.Id()),
@@ -832,16 +1339,23 @@ func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interf
 	}
 
 	// Eventual consistency for "deployed" state
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(time.Until(deadline), func() *resource.RetryError {
 		_, err := conn.DeleteDistribution(params)
 		if err != nil {
-			if isAWSErr(err, cloudfront.ErrCodeDistributionNotDisabled, "The distribution you are trying to delete has not been disabled.") {
+			if isAWSErr(err, cloudfront.ErrCodeDistributionNotDisabled, "") {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
 		}
 		return nil
 	})
+	meta.(*AWSClient).cloudfrontDistributionCache.Invalidate(d.Id())
+	if isAWSErr(err, cloudfront.ErrCodeNoSuchDistribution, "") {
+		return nil
+	}
+	if isAWSErr(err, cloudfront.ErrCodeDistributionNotDisabled, "") {
+		return fmt.Errorf("CloudFront Distribution %s cannot be deleted: the distribution was disabled but the change has not finished propagating, retry the destroy once its status is Deployed: %s", d.Id(), err)
+	}
 	if err != nil {
 		return fmt.Errorf("CloudFront Distribution %s cannot be deleted: %s", d.Id(), err)
 	}
@@ -849,15 +1363,61 @@ func resourceAwsCloudFrontDistributionDelete(d *schema.ResourceData, meta interf
 	return nil
 }
 
+// resourceAwsCloudFrontDistributionDeleteAfterExceedsDeadline reports whether
+// the delete_after window, which starts when the distribution is disabled,
+// ends after the delete deadline. The distribution is then left disabled for
+// manual deletion.
+func resourceAwsCloudFrontDistributionDeleteAfterExceedsDeadline(id string, disabledAt time.Time, deleteAfter time.Duration, deadline time.Time) bool {
+	if deleteAfter == 0 || !disabledAt.Add(deleteAfter).After(deadline) {
+		return false
+	}
+
+	log.Printf("[WARN] Removing CloudFront Distribution ID %q without deleting it: `delete_after` (%s) ends after the delete timeout. The distribution is disabled, please delete it manually after %s.", id, deleteAfter, disabledAt.Add(deleteAfter).Format(time.RFC3339))
+	return true
+}
+
+// resourceAwsCloudFrontDistributionWaitForDeleteAfter waits until the
+// delete_after window, which starts when the distribution is disabled, has
+// passed.
+func resourceAwsCloudFrontDistributionWaitForDeleteAfter(id string, disabledAt time.Time, deleteAfter time.Duration) {
+	if remaining := time.Until(disabledAt.Add(deleteAfter)); remaining > 0 {
+		log.Printf("[DEBUG] Waiting %s for the `delete_after` window of CloudFront Distribution %q", remaining, id)
+		time.Sleep(remaining)
+	}
+}
+
+// resourceAwsCloudFrontDistributionDisable disables the distribution if
+// CloudFront reports it as enabled.
+func resourceAwsCloudFrontDistributionDisable(conn *cloudfront.CloudFront, id string) error {
+	output, err := conn.GetDistributionConfig(&cloudfront.GetDistributionConfigInput{
+		Id: aws.String(id),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !aws.BoolValue(output.DistributionConfig.Enabled) {
+		return nil
+	}
+
+	output.DistributionConfig.Enabled = aws.Bool(false)
+
+	return resourceAwsCloudFrontDistributionUpdateDistribution(conn, &cloudfront.UpdateDistributionInput{
+		Id:                 aws.String(id),
+		DistributionConfig: output.DistributionConfig,
+		IfMatch:            output.ETag,
+	})
+}
+
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
-// distribution is deployed. It currently takes exactly 15 minutes to deploy
-// but that might change in the future.
-func resourceAwsCloudFrontDistributionWaitUntilDeployed(id string, meta interface{}) error {
+// distribution is deployed or the timeout ends. It currently takes exactly 15
+// minutes to deploy but that might change in the future.
+func resourceAwsCloudFrontDistributionWaitUntilDeployed(id string, meta interface{}, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"InProgress"},
 		Target:     []string{"Deployed"},
 		Refresh:    resourceAwsCloudFrontWebDistributionStateRefreshFunc(id, meta),
-		Timeout:    70 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 15 * time.Second,
 		Delay:      10 * time.Minute,
 	}
@@ -887,3 +1447,993 @@ func resourceAwsCloudFrontWebDistributionStateRefreshFunc(id string, meta interf
 		return resp.Distribution, *resp.Distribution.Status, nil
 	}
 }
+
+// cloudFrontDistributionConfigGetter is implemented by both
+// *schema.ResourceData and *schema.ResourceDiff, so the distribution
+// configuration checks can run at plan time as well as from the
+// aws_cloudfront_distribution_config_validation data source.
+type cloudFrontDistributionConfigGetter interface {
+	Get(string) interface{}
+}
+
+// cloudFrontDistributionConfigValidation is a client-side check of a
+// distribution configuration. keys lists the attributes the check reads;
+// at plan time the check is skipped unless all of them are known.
+type cloudFrontDistributionConfigValidation struct {
+	keys     []string
+	validate func(cloudFrontDistributionConfigGetter) error
+}
+
+var cloudFrontDistributionConfigValidations = []cloudFrontDistributionConfigValidation{
+	{
+		keys:     []string{"origin"},
+		validate: validateCloudFrontDistributionOriginCustomHeaders,
+	},
+	{
+		keys:     []string{"origin"},
+		validate: validateCloudFrontDistributionOriginConfigs,
+	},
+	{
+		keys:     []string{"origin", "origin_group", "default_cache_behavior", "ordered_cache_behavior", "ordered_cache_behavior_by_path"},
+		validate: validateCloudFrontDistributionTargetOriginIds,
+	},
+	{
+		keys:     []string{"default_cache_behavior", "ordered_cache_behavior", "ordered_cache_behavior_by_path"},
+		validate: validateCloudFrontDistributionCookies,
+	},
+	{
+		keys:     []string{"restrictions"},
+		validate: validateCloudFrontDistributionGeoRestriction,
+	},
+	{
+		keys:     []string{"default_cache_behavior", "ordered_cache_behavior", "ordered_cache_behavior_by_path"},
+		validate: validateCloudFrontDistributionCachedMethods,
+	},
+	{
+		keys:     []string{"default_cache_behavior", "ordered_cache_behavior", "ordered_cache_behavior_by_path"},
+		validate: validateCloudFrontDistributionLambdaFunctionAssociations,
+	},
+	{
+		keys:     []string{"ordered_cache_behavior", "ordered_cache_behavior_by_path"},
+		validate: validateCloudFrontDistributionPathPatterns,
+	},
+	{
+		// An omitted target_origin_id is indistinguishable from an unknown
+		// one until it is known, so this is also checked before apply.
+		keys:     []string{"default_cache_behavior.0.target_origin_id", "origin"},
+		validate: validateCloudFrontDistributionDefaultTargetOriginId,
+	},
+	{
+		keys:     []string{"origin", "origin_group"},
+		validate: validateCloudFrontDistributionUniqueOriginIds,
+	},
+	{
+		keys:     []string{"origin", "origin_group"},
+		validate: validateCloudFrontDistributionOriginGroupMembers,
+	},
+	{
+		// The whole viewer_certificate block is known even when a
+		// certificate in it is not, so the certificates are listed instead.
+		keys: []string{
+			"viewer_certificate.0.acm_certificate_arn",
+			"viewer_certificate.0.cloudfront_default_certificate",
+			"viewer_certificate.0.iam_certificate_id",
+		},
+		validate: validateCloudFrontDistributionViewerCertificate,
+	},
+	{
+		keys:     []string{"web_acl_id"},
+		validate: validateCloudFrontDistributionWebAclId,
+	},
+}
+
+// Plan time validation of the distribution configuration.
+func resourceAwsCloudFrontDistributionCustomizeDiffConfig(diff *schema.ResourceDiff, v interface{}) error {
+	for _, validation := range cloudFrontDistributionConfigValidations {
+		known := true
+		for _, k := range validation.keys {
+			if !diff.NewValueKnown(k) {
+				known = false
+				break
+			}
+		}
+		if !known {
+			continue
+		}
+
+		if err := validation.validate(diff); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateCloudFrontDistributionOriginCustomHeaders checks origin
+// custom_header names. Sending the same header name more than once to an
+// origin has undefined behavior, so reject it rather than leave it to the
+// origin.
+func validateCloudFrontDistributionOriginCustomHeaders(d cloudFrontDistributionConfigGetter) error {
+	for _, raw := range d.Get("origin").(*schema.Set).List() {
+		origin := raw.(map[string]interface{})
+		if err := validateCloudFrontOriginCustomHeaders(origin["custom_header"].(*schema.Set)); err != nil {
+			return fmt.Errorf("origin (%s): %s", origin["origin_id"].(string), err)
+		}
+	}
+
+	return nil
+}
+
+// validateCloudFrontOriginCustomHeaders returns an error naming the first
+// custom_header name that is used more than once. Header names are compared
+// case-insensitively. The custom_header set hash covers both name and value,
+// so the same name with different values yields separate set elements.
+func validateCloudFrontOriginCustomHeaders(s *schema.Set) error {
+	names := make(map[string]bool)
+	for _, raw := range s.List() {
+		name := raw.(map[string]interface{})["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		key := strings.ToLower(name)
+		if names[key] {
+			return fmt.Errorf("duplicate custom_header name %q", name)
+		}
+		names[key] = true
+	}
+
+	return nil
+}
+
+func validateCloudFrontDistributionOriginConfigs(d cloudFrontDistributionConfigGetter) error {
+	for _, raw := range d.Get("origin").(*schema.Set).List() {
+		origin := raw.(map[string]interface{})
+		if err := validateCloudFrontOriginConfig(origin); err != nil {
+			return fmt.Errorf("origin (%s): %s", origin["origin_id"].(string), err)
+		}
+	}
+
+	return nil
+}
+
+// validateCloudFrontOriginConfig returns an error if an origin has both an
+// s3_origin_config and a custom_origin_config, or if an S3 website endpoint
+// lacks a custom_origin_config. An origin with neither is an S3 origin
+// without an origin access identity.
+func validateCloudFrontOriginConfig(origin map[string]interface{}) error {
+	s3OriginConfig, _ := origin["s3_origin_config"].([]interface{})
+	customOriginConfig, _ := origin["custom_origin_config"].([]interface{})
+	if len(s3OriginConfig) > 0 && len(customOriginConfig) > 0 {
+		return fmt.Errorf("s3_origin_config and custom_origin_config conflict, only one can be set")
+	}
+
+	// Website endpoints only speak plain HTTP and do not accept origin
+	// access identities, so CloudFront must treat them as custom origins.
+	domainName, _ := origin["domain_name"].(string)
+	if cloudFrontS3WebsiteEndpointRegexp.MatchString(domainName) && len(customOriginConfig) == 0 {
+		return fmt.Errorf("%q is an S3 website endpoint, which must be configured with custom_origin_config (origin_protocol_policy = \"http-only\") instead of s3_origin_config; "+
+			"to use an S3 origin instead, set domain_name to the bucket's bucket_regional_domain_name", domainName)
+	}
+
+	return nil
+}
+
+// cloudFrontS3WebsiteEndpointRegexp matches both the dash
+// (s3-website-us-east-1) and dot (s3-website.eu-central-1) forms of S3
+// website endpoint hostnames.
+var cloudFrontS3WebsiteEndpointRegexp = regexp.MustCompile(`\.s3-website[.-][a-z0-9-]+\.amazonaws\.com(\.cn)?\.?$`)
+
+// validateCloudFrontDistributionTargetOriginIds checks cache behavior
+// target_origin_id. Each behavior must target either an origin or an origin
+// group.
+func validateCloudFrontDistributionTargetOriginIds(d cloudFrontDistributionConfigGetter) error {
+	originIds := cloudFrontDistributionOriginIds(d.Get("origin").(*schema.Set), d.Get("origin_group").(*schema.Set))
+
+	// Origin IDs may not be known until apply time
+	if len(originIds) == 0 || originIds[""] {
+		return nil
+	}
+
+	return validateCloudFrontDistributionCacheBehaviors(d, func(behavior map[string]interface{}) error {
+		return validateCloudFrontTargetOriginId(behavior["target_origin_id"].(string), originIds)
+	})
+}
+
+// validateCloudFrontDistributionDefaultTargetOriginId checks that the default
+// cache behavior's target_origin_id is set unless it can be inferred from a
+// single origin.
+func validateCloudFrontDistributionDefaultTargetOriginId(d cloudFrontDistributionConfigGetter) error {
+	if d.Get("default_cache_behavior.0.target_origin_id").(string) != "" {
+		return nil
+	}
+
+	if n := d.Get("origin").(*schema.Set).Len(); n != 1 {
+		return fmt.Errorf("default_cache_behavior: target_origin_id must be set when the distribution has %d origins", n)
+	}
+
+	return nil
+}
+
+// suppressCloudFrontDistributionInferredTargetOriginId suppresses the diff
+// when the default cache behavior's target_origin_id is omitted and the
+// current value is the origin_id of the only origin, which is the value that
+// expandDistributionConfig infers.
+func suppressCloudFrontDistributionInferredTargetOriginId(k, old, new string, d *schema.ResourceData) bool {
+	if new != "" {
+		return false
+	}
+
+	origins := d.Get("origin").(*schema.Set).List()
+	return len(origins) == 1 && origins[0].(map[string]interface{})["origin_id"].(string) == old
+}
+
+// validateCloudFrontDistributionCookies checks cache behavior cookie
+// forwarding. CloudFront ignores whitelisted_names unless cookies are
+// forwarded by whitelist.
+func validateCloudFrontDistributionCookies(d cloudFrontDistributionConfigGetter) error {
+	return validateCloudFrontDistributionCacheBehaviors(d, func(behavior map[string]interface{}) error {
+		return validateCloudFrontForwardedValuesCookies(behavior["forwarded_values"].([]interface{}))
+	})
+}
+
+// validateCloudFrontDistributionCachedMethods checks that each cache
+// behavior only caches responses to methods it allows.
+func validateCloudFrontDistributionCachedMethods(d cloudFrontDistributionConfigGetter) error {
+	return validateCloudFrontDistributionCacheBehaviors(d, func(behavior map[string]interface{}) error {
+		return validateCloudFrontCachedMethods(behavior["allowed_methods"].(*schema.Set), behavior["cached_methods"].(*schema.Set))
+	})
+}
+
+// validateCloudFrontCachedMethods returns an error naming the first cached
+// method that is not an allowed method.
+func validateCloudFrontCachedMethods(allowedMethods, cachedMethods *schema.Set) error {
+	// Unknown at plan time
+	if allowedMethods.Len() == 0 {
+		return nil
+	}
+
+	for _, method := range sortInterfaceSlice(cachedMethods.List()) {
+		if method.(string) != "" && !allowedMethods.Contains(method) {
+			return fmt.Errorf("cached_methods %q is not one of the allowed_methods", method.(string))
+		}
+	}
+
+	return nil
+}
+
+// validateCloudFrontDistributionLambdaFunctionAssociations checks that each
+// cache behavior has at most one Lambda function per event type.
+func validateCloudFrontDistributionLambdaFunctionAssociations(d cloudFrontDistributionConfigGetter) error {
+	return validateCloudFrontDistributionCacheBehaviors(d, func(behavior map[string]interface{}) error {
+		return validateCloudFrontLambdaFunctionAssociations(behavior["lambda_function_association"].(*schema.Set))
+	})
+}
+
+// validateCloudFrontLambdaFunctionAssociations returns an error naming the
+// first event_type that is associated more than once.
+func validateCloudFrontLambdaFunctionAssociations(s *schema.Set) error {
+	eventTypes := make(map[string]bool)
+	for _, raw := range s.List() {
+		eventType := raw.(map[string]interface{})["event_type"].(string)
+		if eventType == "" {
+			continue
+		}
+
+		if eventTypes[eventType] {
+			return fmt.Errorf("duplicate lambda_function_association event_type %q", eventType)
+		}
+		eventTypes[eventType] = true
+	}
+
+	return nil
+}
+
+// validateCloudFrontDistributionPathPatterns checks that ordered cache
+// behaviors have unique path patterns.
+func validateCloudFrontDistributionPathPatterns(d cloudFrontDistributionConfigGetter) error {
+	return validateCloudFrontPathPatterns(cloudFrontDistributionOrderedCacheBehaviors(d))
+}
+
+// validateCloudFrontPathPatterns returns an error naming the first
+// path_pattern that is used by more than one ordered cache behavior.
+func validateCloudFrontPathPatterns(key string, orderedCacheBehaviors []interface{}) error {
+	pathPatterns := make(map[string]int)
+	for i, raw := range orderedCacheBehaviors {
+		if raw == nil {
+			continue
+		}
+
+		pathPattern := raw.(map[string]interface{})["path_pattern"].(string)
+		if pathPattern == "" {
+			continue
+		}
+
+		if j, ok := pathPatterns[pathPattern]; ok {
+			return fmt.Errorf("%s.%d: duplicate path_pattern %q, also used by %s.%d", key, i, pathPattern, key, j)
+		}
+		pathPatterns[pathPattern] = i
+	}
+
+	return nil
+}
+
+// validateCloudFrontDistributionCacheBehaviors calls f for the default and
+// each ordered cache behavior, prefixing any error with the behavior.
+func validateCloudFrontDistributionCacheBehaviors(d cloudFrontDistributionConfigGetter, f func(map[string]interface{}) error) error {
+	for _, raw := range d.Get("default_cache_behavior").([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		if err := f(raw.(map[string]interface{})); err != nil {
+			return fmt.Errorf("default_cache_behavior: %s", err)
+		}
+	}
+
+	key, orderedCacheBehaviors := cloudFrontDistributionOrderedCacheBehaviors(d)
+	for i, raw := range orderedCacheBehaviors {
+		if raw == nil {
+			continue
+		}
+		if err := f(raw.(map[string]interface{})); err != nil {
+			return fmt.Errorf("%s.%d: %s", key, i, err)
+		}
+	}
+
+	return nil
+}
+
+// cloudFrontDistributionOrderedCacheBehaviors returns the name of the
+// attribute configuring the ordered cache behaviors and the behaviors in the
+// order CloudFront evaluates them. ordered_cache_behavior_by_path behaviors
+// are sorted by precedence, then path_pattern.
+func cloudFrontDistributionOrderedCacheBehaviors(d cloudFrontDistributionConfigGetter) (string, []interface{}) {
+	if s, ok := d.Get("ordered_cache_behavior_by_path").(*schema.Set); ok && s.Len() > 0 {
+		behaviors := s.List()
+		sort.SliceStable(behaviors, func(i, j int) bool {
+			a, b := behaviors[i].(map[string]interface{}), behaviors[j].(map[string]interface{})
+			if a["precedence"].(int) != b["precedence"].(int) {
+				return a["precedence"].(int) < b["precedence"].(int)
+			}
+			return a["path_pattern"].(string) < b["path_pattern"].(string)
+		})
+		return "ordered_cache_behavior_by_path", behaviors
+	}
+
+	orderedCacheBehaviors, _ := d.Get("ordered_cache_behavior").([]interface{})
+	return "ordered_cache_behavior", orderedCacheBehaviors
+}
+
+func validateCloudFrontForwardedValuesCookies(forwardedValues []interface{}) error {
+	for _, rawForwardedValues := range forwardedValues {
+		if rawForwardedValues == nil {
+			continue
+		}
+
+		for _, rawCookies := range rawForwardedValues.(map[string]interface{})["cookies"].([]interface{}) {
+			if rawCookies == nil {
+				continue
+			}
+			cookies := rawCookies.(map[string]interface{})
+
+			forward := cookies["forward"].(string)
+			// Unknown at plan time
+			if forward == "" || forward == cloudfront.ItemSelectionWhitelist {
+				continue
+			}
+
+			if cookies["whitelisted_names"].(*schema.Set).Len() > 0 {
+				return fmt.Errorf("cookies whitelisted_names can only be set when forward is %q, got %q", cloudfront.ItemSelectionWhitelist, forward)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCloudFrontDistributionGeoRestriction checks geo_restriction
+// locations against the restriction type.
+func validateCloudFrontDistributionGeoRestriction(d cloudFrontDistributionConfigGetter) error {
+	for _, rawRestrictions := range d.Get("restrictions").([]interface{}) {
+		if rawRestrictions == nil {
+			continue
+		}
+
+		for _, raw := range rawRestrictions.(map[string]interface{})["geo_restriction"].([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			if err := validateCloudFrontGeoRestriction(raw.(map[string]interface{})); err != nil {
+				return fmt.Errorf("restrictions: %s", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCloudFrontGeoRestriction returns an error unless locations is empty
+// for restriction type none and non-empty for whitelist and blacklist.
+func validateCloudFrontGeoRestriction(m map[string]interface{}) error {
+	restrictionType := m["restriction_type"].(string)
+	locations := m["locations"].(*schema.Set).Len()
+
+	switch restrictionType {
+	case cloudfront.GeoRestrictionTypeNone:
+		if locations > 0 {
+			return fmt.Errorf("geo_restriction locations must be empty when restriction_type is %q", restrictionType)
+		}
+	case cloudfront.GeoRestrictionTypeWhitelist, cloudfront.GeoRestrictionTypeBlacklist:
+		if locations == 0 {
+			return fmt.Errorf("geo_restriction locations must not be empty when restriction_type is %q", restrictionType)
+		}
+	}
+
+	return nil
+}
+
+// cloudFrontDistributionOriginIds returns the IDs of all origins and origin
+// groups, i.e. all valid cache behavior targets.
+func cloudFrontDistributionOriginIds(origins, originGroups *schema.Set) map[string]bool {
+	ids := make(map[string]bool)
+	for _, raw := range origins.List() {
+		ids[raw.(map[string]interface{})["origin_id"].(string)] = true
+	}
+	for _, raw := range originGroups.List() {
+		ids[raw.(map[string]interface{})["origin_id"].(string)] = true
+	}
+
+	return ids
+}
+
+// validateCloudFrontDistributionUniqueOriginIds checks that origins and
+// origin groups, which share a namespace, do not reuse an origin_id.
+func validateCloudFrontDistributionUniqueOriginIds(d cloudFrontDistributionConfigGetter) error {
+	return validateCloudFrontUniqueOriginIds(d.Get("origin").(*schema.Set), d.Get("origin_group").(*schema.Set))
+}
+
+func validateCloudFrontUniqueOriginIds(origins, originGroups *schema.Set) error {
+	kinds := make(map[string]string)
+	check := func(raw interface{}, kind string) error {
+		id := raw.(map[string]interface{})["origin_id"].(string)
+		// Unknown at plan time
+		if id == "" {
+			return nil
+		}
+
+		if other, ok := kinds[id]; ok {
+			if other == kind {
+				return fmt.Errorf("duplicate origin_id %q used by more than one %s", id, kind)
+			}
+			return fmt.Errorf("duplicate origin_id %q used by both an origin and an origin_group", id)
+		}
+		kinds[id] = kind
+
+		return nil
+	}
+
+	for _, raw := range origins.List() {
+		if err := check(raw, "origin"); err != nil {
+			return err
+		}
+	}
+	for _, raw := range originGroups.List() {
+		if err := check(raw, "origin_group"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateCloudFrontDistributionOriginGroupMembers checks that each origin
+// group's members are distinct origins of the distribution.
+func validateCloudFrontDistributionOriginGroupMembers(d cloudFrontDistributionConfigGetter) error {
+	return validateCloudFrontOriginGroupMembers(d.Get("origin").(*schema.Set), d.Get("origin_group").(*schema.Set))
+}
+
+// validateCloudFrontOriginGroupMembers returns an error naming the first
+// origin group member that is listed twice or that does not match the
+// origin_id of an origin. Origin groups cannot be members of other groups.
+func validateCloudFrontOriginGroupMembers(origins, originGroups *schema.Set) error {
+	originIds := make(map[string]bool)
+	for _, raw := range origins.List() {
+		originIds[raw.(map[string]interface{})["origin_id"].(string)] = true
+	}
+
+	// Origin IDs may not be known until apply time
+	if originIds[""] {
+		return nil
+	}
+
+	for _, raw := range originGroups.List() {
+		originGroup := raw.(map[string]interface{})
+		members := make(map[string]bool)
+		for _, rawMember := range originGroup["member"].([]interface{}) {
+			if rawMember == nil {
+				continue
+			}
+
+			id := rawMember.(map[string]interface{})["origin_id"].(string)
+			// Unknown at plan time
+			if id == "" {
+				continue
+			}
+
+			if members[id] {
+				return fmt.Errorf("origin_group (%s): duplicate member origin_id %q", originGroup["origin_id"].(string), id)
+			}
+			members[id] = true
+
+			if !originIds[id] {
+				return fmt.Errorf("origin_group (%s): member origin_id %q does not match the origin_id of any origin", originGroup["origin_id"].(string), id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateCloudFrontDistributionViewerCertificate(d cloudFrontDistributionConfigGetter) error {
+	viewerCertificate := d.Get("viewer_certificate").([]interface{})
+	if len(viewerCertificate) == 0 || viewerCertificate[0] == nil {
+		return nil
+	}
+
+	return validateCloudFrontViewerCertificate(viewerCertificate[0].(map[string]interface{}))
+}
+
+// validateCloudFrontDistributionWebAclId checks that web_acl_id refers to a
+// web ACL CloudFront can use. CloudFront rejects the others with a generic
+// InvalidWebACLId error.
+func validateCloudFrontDistributionWebAclId(d cloudFrontDistributionConfigGetter) error {
+	return validateCloudFrontWebAclId(d.Get("web_acl_id").(string))
+}
+
+// validateCloudFrontWebAclId accepts the ID of a classic WAF web ACL or the
+// ARN of a WAFv2 web ACL with CLOUDFRONT scope. Regional web ACLs, classic or
+// WAFv2, cannot be associated with a distribution.
+func validateCloudFrontWebAclId(id string) error {
+	if !strings.HasPrefix(id, "arn:") {
+		// Classic WAF web ACLs are referenced by ID.
+		return nil
+	}
+
+	parsedArn, err := arn.Parse(id)
+	if err != nil {
+		return fmt.Errorf("web_acl_id (%s) is an invalid ARN: %s", id, err)
+	}
+
+	switch parsedArn.Service {
+	case "wafv2":
+		if strings.HasPrefix(parsedArn.Resource, "regional/webacl/") {
+			return fmt.Errorf("web_acl_id (%s) is a WAFv2 web ACL with REGIONAL scope, CloudFront requires a web ACL created with CLOUDFRONT scope in us-east-1", id)
+		}
+		if !strings.HasPrefix(parsedArn.Resource, "global/webacl/") {
+			return fmt.Errorf("web_acl_id (%s) must be the ARN of a WAFv2 web ACL with CLOUDFRONT scope", id)
+		}
+	case "waf-regional":
+		return fmt.Errorf("web_acl_id (%s) is an AWS WAF Regional web ACL, CloudFront requires a global AWS WAF web ACL ID or a WAFv2 web ACL with CLOUDFRONT scope", id)
+	case "waf":
+		return fmt.Errorf("web_acl_id (%s) is a classic AWS WAF web ACL ARN, use the web ACL ID (%s) instead", id, strings.TrimPrefix(parsedArn.Resource, "webacl/"))
+	default:
+		return fmt.Errorf("web_acl_id (%s) must be a classic AWS WAF web ACL ID or a WAFv2 web ACL ARN", id)
+	}
+
+	return nil
+}
+
+// validateCloudFrontViewerCertificate returns an error unless exactly one
+// certificate source is set in the viewer_certificate block.
+func validateCloudFrontViewerCertificate(m map[string]interface{}) error {
+	var sources []string
+	for _, k := range []string{"acm_certificate_arn", "iam_certificate_id"} {
+		if v, ok := m[k].(string); ok && v != "" {
+			sources = append(sources, k)
+		}
+	}
+	if v, ok := m["cloudfront_default_certificate"].(bool); ok && v {
+		sources = append(sources, "cloudfront_default_certificate")
+	}
+
+	switch len(sources) {
+	case 0:
+		return fmt.Errorf("viewer_certificate: one of acm_certificate_arn or iam_certificate_id must be set when cloudfront_default_certificate is false")
+	case 1:
+		return nil
+	default:
+		return fmt.Errorf("viewer_certificate: %s conflict, only one certificate source can be set", strings.Join(sources, " and "))
+	}
+}
+
+func validateCloudFrontTargetOriginId(targetOriginId string, originIds map[string]bool) error {
+	// Unknown at plan time
+	if targetOriginId == "" {
+		return nil
+	}
+
+	if !originIds[targetOriginId] {
+		return fmt.Errorf("target_origin_id %q does not match the origin_id of any origin or origin_group", targetOriginId)
+	}
+
+	return nil
+}
+
+// Plan time warning for aliases served with the default certificate.
+// CloudFront cannot serve HTTPS for aliases using the *.cloudfront.net
+// certificate. This is only logged, as HTTP-only aliases are valid.
+func resourceAwsCloudFrontDistributionCustomizeDiffAliasesCertificate(diff *schema.ResourceDiff, v interface{}) error {
+	if cloudFrontDistributionAliasesUseDefaultCertificate(diff.Get("aliases").(*schema.Set), diff.Get("viewer_certificate").([]interface{})) {
+		log.Printf("[WARN] CloudFront Distribution (%s) has aliases but uses the default CloudFront certificate, HTTPS requests to the aliases will fail. Use an ACM certificate (acm_certificate_arn) covering the aliases to serve them over HTTPS.", diff.Id())
+	}
+
+	return nil
+}
+
+// cloudFrontDistributionAliasesUseDefaultCertificate reports whether any
+// aliases are configured alongside cloudfront_default_certificate.
+func cloudFrontDistributionAliasesUseDefaultCertificate(aliases *schema.Set, viewerCertificate []interface{}) bool {
+	if aliases.Len() == 0 || len(viewerCertificate) == 0 || viewerCertificate[0] == nil {
+		return false
+	}
+
+	return viewerCertificate[0].(map[string]interface{})["cloudfront_default_certificate"].(bool)
+}
+
+// Plan time warning for aliases added without a certificate covering them.
+// CloudFront rejects an alias the certificate does not cover, so the
+// certificate has to be updated first. The ACM certificate is looked up on a
+// best effort basis, if it cannot be read a reminder is logged instead. This
+// is only logged, as the certificate may be updated in the same apply.
+func resourceAwsCloudFrontDistributionCustomizeDiffAliasesAdded(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("aliases") || !diff.NewValueKnown("aliases") {
+		return nil
+	}
+
+	o, n := diff.GetChange("aliases")
+	var aliases []string
+	for _, v := range n.(*schema.Set).Difference(o.(*schema.Set)).List() {
+		aliases = append(aliases, v.(string))
+	}
+	if len(aliases) == 0 {
+		return nil
+	}
+	sort.Strings(aliases)
+
+	// Aliases with the default certificate are warned about separately
+	if diff.Get("viewer_certificate.0.cloudfront_default_certificate").(bool) {
+		return nil
+	}
+
+	reminder := func() {
+		log.Printf("[INFO] CloudFront Distribution (%s) adds aliases %s, make sure the viewer certificate covers them before applying or the update will fail.", diff.Id(), strings.Join(aliases, ", "))
+	}
+
+	arn := diff.Get("viewer_certificate.0.acm_certificate_arn").(string)
+	client, ok := meta.(*AWSClient)
+	if !diff.NewValueKnown("viewer_certificate.0.acm_certificate_arn") || arn == "" || !ok || client.acmconn == nil {
+		reminder()
+		return nil
+	}
+
+	conn, err := cloudFrontAcmConnForCertificate(client.acmconn, arn)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to create ACM client to check CloudFront Distribution (%s) aliases: %s", diff.Id(), err)
+		reminder()
+		return nil
+	}
+
+	uncovered, err := cloudFrontAliasesNotCoveredByAcmCertificate(conn, arn, aliases)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to read ACM Certificate (%s) to check CloudFront Distribution (%s) aliases: %s", arn, diff.Id(), err)
+		reminder()
+		return nil
+	}
+	if len(uncovered) > 0 {
+		log.Printf("[WARN] CloudFront Distribution (%s) adds aliases %s that ACM Certificate (%s) does not cover, the update will fail unless the certificate is replaced first.", diff.Id(), strings.Join(uncovered, ", "), arn)
+	}
+
+	return nil
+}
+
+// cloudFrontAcmConnForCertificate returns an ACM client for the region of the
+// certificate. CloudFront only uses certificates in us-east-1, which need not
+// be the provider's region.
+func cloudFrontAcmConnForCertificate(conn *acm.ACM, certificateArn string) (*acm.ACM, error) {
+	parsedArn, err := arn.Parse(certificateArn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Regions are the same, no need to reconfigure
+	if aws.StringValue(conn.Config.Region) == parsedArn.Region {
+		return conn, nil
+	}
+
+	sess, err := session.NewSession(&conn.Config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %s", err)
+	}
+
+	return acm.New(sess.Copy(&aws.Config{Region: aws.String(parsedArn.Region)})), nil
+}
+
+// cloudFrontAliasesNotCoveredByAcmCertificate returns the aliases that match
+// neither the domain name nor a subject alternative name of the certificate.
+func cloudFrontAliasesNotCoveredByAcmCertificate(conn *acm.ACM, arn string, aliases []string) ([]string, error) {
+	output, err := conn.DescribeCertificate(&acm.DescribeCertificateInput{
+		CertificateArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if output.Certificate == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	names := aws.StringValueSlice(output.Certificate.SubjectAlternativeNames)
+	names = append(names, aws.StringValue(output.Certificate.DomainName))
+
+	var uncovered []string
+	for _, alias := range aliases {
+		covered := false
+		for _, name := range names {
+			if cloudFrontCertificateNameCoversAlias(name, alias) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			uncovered = append(uncovered, alias)
+		}
+	}
+
+	return uncovered, nil
+}
+
+// cloudFrontCertificateNameCoversAlias reports whether a certificate name
+// matches an alias. A wildcard name matches a single leftmost label, so
+// *.example.com covers www.example.com but not example.com or
+// a.b.example.com. A wildcard alias is only covered by the same wildcard.
+func cloudFrontCertificateNameCoversAlias(name, alias string) bool {
+	name = strings.ToLower(name)
+	alias = strings.ToLower(alias)
+	if name == alias {
+		return true
+	}
+
+	if !strings.HasPrefix(name, "*.") || strings.HasPrefix(alias, "*.") {
+		return false
+	}
+	i := strings.Index(alias, ".")
+	return i > 0 && alias[i:] == name[1:]
+}
+
+// cloudFrontDistributionDefaultAliasesLimit is the default service quota for
+// alternate domain names (CNAMEs) per distribution.
+const cloudFrontDistributionDefaultAliasesLimit = 100
+
+// Plan time warning for more aliases than the default quota allows.
+// The quota can be raised, so this is only logged.
+func resourceAwsCloudFrontDistributionCustomizeDiffAliasesLimit(diff *schema.ResourceDiff, v interface{}) error {
+	if n := diff.Get("aliases").(*schema.Set).Len(); n > cloudFrontDistributionDefaultAliasesLimit {
+		log.Printf("[WARN] CloudFront Distribution (%s) has %d aliases, more than the default limit of %d. Creating or updating the distribution will fail unless a service quota increase has been granted for the account.", diff.Id(), n, cloudFrontDistributionDefaultAliasesLimit)
+	}
+
+	return nil
+}
+
+// Plan time warning for the Host header forwarded to S3 origins.
+// S3 uses the Host header to look up the bucket, so forwarding the viewer's
+// Host header makes every request to the origin fail.
+func resourceAwsCloudFrontDistributionCustomizeDiffS3OriginHostHeader(diff *schema.ResourceDiff, v interface{}) error {
+	s3OriginIds := cloudFrontDistributionS3OriginIds(diff.Get("origin").(*schema.Set))
+
+	_, orderedCacheBehaviors := cloudFrontDistributionOrderedCacheBehaviors(diff)
+	behaviors := diff.Get("default_cache_behavior").([]interface{})
+	behaviors = append(behaviors, orderedCacheBehaviors...)
+	for _, raw := range behaviors {
+		if raw == nil {
+			continue
+		}
+		behavior := raw.(map[string]interface{})
+		if cloudFrontCacheBehaviorForwardsHostToS3Origin(behavior, s3OriginIds) {
+			log.Printf("[WARN] CloudFront Distribution (%s) forwards the Host header to S3 origin (%s), requests to the origin will fail. Remove Host from forwarded_values headers or use a custom_origin_config.", diff.Id(), behavior["target_origin_id"].(string))
+		}
+	}
+
+	return nil
+}
+
+// cloudFrontDistributionS3OriginIds returns the IDs of origins without a
+// custom_origin_config, i.e. S3 origins.
+func cloudFrontDistributionS3OriginIds(origins *schema.Set) map[string]bool {
+	ids := make(map[string]bool)
+	for _, raw := range origins.List() {
+		origin := raw.(map[string]interface{})
+		if v, ok := origin["custom_origin_config"].([]interface{}); !ok || len(v) == 0 {
+			ids[origin["origin_id"].(string)] = true
+		}
+	}
+
+	return ids
+}
+
+// cloudFrontCacheBehaviorForwardsHostToS3Origin reports whether a cache
+// behavior forwards the Host header, either by name or with "*", to one of
+// the given S3 origins.
+func cloudFrontCacheBehaviorForwardsHostToS3Origin(behavior map[string]interface{}, s3OriginIds map[string]bool) bool {
+	if !s3OriginIds[behavior["target_origin_id"].(string)] {
+		return false
+	}
+
+	for _, rawForwardedValues := range behavior["forwarded_values"].([]interface{}) {
+		if rawForwardedValues == nil {
+			continue
+		}
+		for _, header := range rawForwardedValues.(map[string]interface{})["headers"].(*schema.Set).List() {
+			if header.(string) == "*" || strings.EqualFold(header.(string), "Host") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Plan time notice for cache behaviors restricted to signed URLs and cookies.
+func resourceAwsCloudFrontDistributionCustomizeDiffTrustedSigners(diff *schema.ResourceDiff, v interface{}) error {
+	key, orderedCacheBehaviors := cloudFrontDistributionOrderedCacheBehaviors(diff)
+	for _, k := range cloudFrontDistributionTrustedSignersBehaviors(diff.Get("default_cache_behavior").([]interface{}), key, orderedCacheBehaviors) {
+		log.Printf("[INFO] CloudFront Distribution (%s) %s has trusted_signers, viewers must use signed URLs or signed cookies to access its content", diff.Id(), k)
+	}
+
+	return nil
+}
+
+// Plan time notice for distributions that pay for every edge location while
+// blocking viewers in some countries, where a lower price class may cost less.
+func resourceAwsCloudFrontDistributionCustomizeDiffPriceClass(diff *schema.ResourceDiff, v interface{}) error {
+	if locations := cloudFrontPriceClassAllBlacklistedLocations(diff.Get("price_class").(string), diff.Get("restrictions").([]interface{})); len(locations) > 0 {
+		log.Printf("[INFO] CloudFront Distribution (%s) uses price_class %q with a geo restriction blacklist of %d locations. If the blacklist excludes whole regions, a lower price class (%q or %q) may reduce costs without affecting the remaining viewers.", diff.Id(), cloudfront.PriceClassPriceClassAll, len(locations), cloudfront.PriceClassPriceClass200, cloudfront.PriceClassPriceClass100)
+	}
+
+	return nil
+}
+
+// tags_all is planned as the tags the distribution will have after apply:
+// the provider's default tags merged with tags.
+func resourceAwsCloudFrontDistributionCustomizeDiffTagsAll(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	var defaultTags map[string]interface{}
+	if client, ok := meta.(*AWSClient); ok {
+		defaultTags = client.cloudfrontDefaultTags
+	}
+
+	tagsAll := tagsWithDefaultsCloudFront(defaultTags, diff.Get("tags").(map[string]interface{}))
+	if reflect.DeepEqual(tagsAll, diff.Get("tags_all").(map[string]interface{})) {
+		return nil
+	}
+
+	return diff.SetNew("tags_all", tagsAll)
+}
+
+// cloudFrontPriceClassAllBlacklistedLocations returns the blacklisted geo
+// restriction locations when the price class is PriceClass_All.
+func cloudFrontPriceClassAllBlacklistedLocations(priceClass string, restrictions []interface{}) []interface{} {
+	if priceClass != cloudfront.PriceClassPriceClassAll || len(restrictions) == 0 || restrictions[0] == nil {
+		return nil
+	}
+
+	for _, raw := range restrictions[0].(map[string]interface{})["geo_restriction"].([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		geoRestriction := raw.(map[string]interface{})
+		if geoRestriction["restriction_type"].(string) != cloudfront.GeoRestrictionTypeBlacklist {
+			continue
+		}
+		if locations, ok := geoRestriction["locations"].(*schema.Set); ok {
+			return locations.List()
+		}
+	}
+
+	return nil
+}
+
+// cloudFrontDistributionTrustedSignersBehaviors returns the attribute paths of
+// the cache behaviors with non-empty trusted_signers. Ordered cache behaviors
+// are reported under orderedCacheBehaviorsKey, the attribute they came from.
+func cloudFrontDistributionTrustedSignersBehaviors(defaultCacheBehavior []interface{}, orderedCacheBehaviorsKey string, orderedCacheBehaviors []interface{}) []string {
+	var paths []string
+
+	hasTrustedSigners := func(raw interface{}) bool {
+		if raw == nil {
+			return false
+		}
+		v, ok := raw.(map[string]interface{})["trusted_signers"].([]interface{})
+		return ok && len(v) > 0
+	}
+
+	for _, raw := range defaultCacheBehavior {
+		if hasTrustedSigners(raw) {
+			paths = append(paths, "default_cache_behavior")
+		}
+	}
+	for i, raw := range orderedCacheBehaviors {
+		if hasTrustedSigners(raw) {
+			paths = append(paths, fmt.Sprintf("%s.%d", orderedCacheBehaviorsKey, i))
+		}
+	}
+
+	return paths
+}
+
+// cloudFrontHttpsEndpointDomainRegexp matches the domain names of AWS managed
+// endpoints that serve HTTPS, such as load balancers and API Gateway APIs.
+var cloudFrontHttpsEndpointDomainRegexp = regexp.MustCompile(`(?i)(\.elb\.amazonaws\.com|\.execute-api\.[a-z0-9-]+\.amazonaws\.com)$`)
+
+// Plan time warning for custom origins that send plain HTTP to AWS managed
+// endpoints which support HTTPS. This is only logged, as it may be intended.
+func resourceAwsCloudFrontDistributionCustomizeDiffHttpOnlyOrigins(diff *schema.ResourceDiff, v interface{}) error {
+	for _, raw := range diff.Get("origin").(*schema.Set).List() {
+		origin := raw.(map[string]interface{})
+		if cloudFrontOriginIsHttpOnlyToHttpsEndpoint(origin) {
+			log.Printf("[WARN] CloudFront Distribution (%s) origin (%s) uses origin_protocol_policy \"http-only\" for %s, which supports HTTPS. Requests to the origin are not encrypted, consider \"https-only\".", diff.Id(), origin["origin_id"].(string), origin["domain_name"].(string))
+		}
+	}
+
+	return nil
+}
+
+// cloudFrontOriginIsHttpOnlyToHttpsEndpoint reports whether the origin is a
+// custom origin using http-only for an AWS managed HTTPS endpoint.
+func cloudFrontOriginIsHttpOnlyToHttpsEndpoint(origin map[string]interface{}) bool {
+	customOriginConfig, ok := origin["custom_origin_config"].([]interface{})
+	if !ok || len(customOriginConfig) == 0 || customOriginConfig[0] == nil {
+		return false
+	}
+
+	if customOriginConfig[0].(map[string]interface{})["origin_protocol_policy"].(string) != "http-only" {
+		return false
+	}
+
+	return cloudFrontHttpsEndpointDomainRegexp.MatchString(origin["domain_name"].(string))
+}
+
+// cloudFrontLoggingUnsupportedRegions are the regions whose S3 buckets
+// CloudFront standard logging cannot deliver to.
+// https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/AccessLogs.html#access-logs-choosing-s3-bucket
+var cloudFrontLoggingUnsupportedRegions = map[string]bool{
+	"af-south-1":     true,
+	"ap-east-1":      true,
+	"ap-south-2":     true,
+	"ap-southeast-3": true,
+	"ap-southeast-4": true,
+	"ca-west-1":      true,
+	"eu-central-2":   true,
+	"eu-south-1":     true,
+	"eu-south-2":     true,
+	"il-central-1":   true,
+	"me-central-1":   true,
+	"me-south-1":     true,
+}
+
+// cloudFrontLoggingBucketRegionRegexp extracts the region from regional S3
+// bucket domain names, e.g. mylogs.s3.af-south-1.amazonaws.com or
+// mylogs.s3-eu-south-1.amazonaws.com.
+var cloudFrontLoggingBucketRegionRegexp = regexp.MustCompile(`\.s3[.-](?:dualstack\.)?([a-z]{2}(?:-gov)?-[a-z]+-\d)\.amazonaws\.com$`)
+
+// cloudFrontLoggingBucketRegion returns the region named in the logging
+// bucket domain name, or "" if the domain name does not include one.
+func cloudFrontLoggingBucketRegion(bucket string) string {
+	m := cloudFrontLoggingBucketRegionRegexp.FindStringSubmatch(bucket)
+	if m == nil {
+		return ""
+	}
+
+	return m[1]
+}
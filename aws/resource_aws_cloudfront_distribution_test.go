@@ -4,13 +4,23 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"regexp"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -56,6 +66,18 @@ func testSweepCloudFrontDistributions(region string) error {
 			continue
 		}
 
+		tagsOutput, err := conn.ListTagsForResource(&cloudfront.ListTagsForResourceInput{
+			Resource: distributionSummary.ARN,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing tags for CloudFront Distribution %s: %s", distributionID, err)
+		}
+
+		if testSweepCloudFrontDistributionSkip(tagsOutput.Tags) {
+			log.Printf("[INFO] Skipping deletion of CloudFront Distribution %s tagged %s = true", distributionID, testSweepCloudFrontDistributionSkipTagKey)
+			continue
+		}
+
 		output, err := conn.GetDistribution(&cloudfront.GetDistributionInput{
 			Id: aws.String(distributionID),
 		})
@@ -75,855 +97,5700 @@ func testSweepCloudFrontDistributions(region string) error {
 	return nil
 }
 
-// TestAccAWSCloudFrontDistribution_S3Origin runs an
-// aws_cloudfront_distribution acceptance test with a single S3 origin.
-//
-// If you are testing manually and can't wait for deletion, set the
-// TF_TEST_CLOUDFRONT_RETAIN environment variable.
-func TestAccAWSCloudFrontDistribution_S3Origin(t *testing.T) {
-	ri := acctest.RandInt()
-	testConfig := fmt.Sprintf(testAccAWSCloudFrontDistributionS3Config, ri, originBucket, logBucket, testAccAWSCloudFrontDistributionRetainConfig())
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testConfig,
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCloudFrontDistributionExistence(
-						"aws_cloudfront_distribution.s3_distribution",
-					),
-					resource.TestCheckResourceAttr(
-						"aws_cloudfront_distribution.s3_distribution",
-						"hosted_zone_id",
-						"Z2FDTNDATAQYW2",
-					),
-				),
-			},
-			{
-				ResourceName:            "aws_cloudfront_distribution.s3_distribution",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retain_on_delete"},
-			},
-		},
-	})
+// testSweepCloudFrontDistributionSkipTagKey is the tag that protects a
+// distribution, such as a long-lived fixture in a shared account, from the
+// sweeper when its value is "true".
+const testSweepCloudFrontDistributionSkipTagKey = "terraform-sweeper-skip"
+
+func testSweepCloudFrontDistributionSkip(tags *cloudfront.Tags) bool {
+	if tags == nil {
+		return false
+	}
+
+	for _, tag := range tags.Items {
+		if aws.StringValue(tag.Key) == testSweepCloudFrontDistributionSkipTagKey {
+			return strings.EqualFold(aws.StringValue(tag.Value), "true")
+		}
+	}
+
+	return false
 }
 
-func TestAccAWSCloudFrontDistribution_S3OriginWithTags(t *testing.T) {
-	ri := acctest.RandInt()
-	preConfig := fmt.Sprintf(testAccAWSCloudFrontDistributionS3ConfigWithTags, ri, originBucket, logBucket, testAccAWSCloudFrontDistributionRetainConfig())
-	postConfig := fmt.Sprintf(testAccAWSCloudFrontDistributionS3ConfigWithTagsUpdated, ri, originBucket, logBucket, testAccAWSCloudFrontDistributionRetainConfig())
+func TestSweepCloudFrontDistributionSkip(t *testing.T) {
+	cases := []struct {
+		label    string
+		tags     *cloudfront.Tags
+		expected bool
+	}{
+		{"no tags", nil, false},
+		{"empty tags", &cloudfront.Tags{}, false},
+		{"other tags", &cloudfront.Tags{Items: []*cloudfront.Tag{
+			{Key: aws.String("environment"), Value: aws.String("test")},
+		}}, false},
+		{"skip tag true", &cloudfront.Tags{Items: []*cloudfront.Tag{
+			{Key: aws.String("environment"), Value: aws.String("test")},
+			{Key: aws.String("terraform-sweeper-skip"), Value: aws.String("true")},
+		}}, true},
+		{"skip tag TRUE", &cloudfront.Tags{Items: []*cloudfront.Tag{
+			{Key: aws.String("terraform-sweeper-skip"), Value: aws.String("TRUE")},
+		}}, true},
+		{"skip tag false", &cloudfront.Tags{Items: []*cloudfront.Tag{
+			{Key: aws.String("terraform-sweeper-skip"), Value: aws.String("false")},
+		}}, false},
+	}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: preConfig,
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCloudFrontDistributionExistence(
-						"aws_cloudfront_distribution.s3_distribution",
-					),
-					resource.TestCheckResourceAttr(
-						"aws_cloudfront_distribution.s3_distribution", "tags.%", "2"),
-					resource.TestCheckResourceAttr(
-						"aws_cloudfront_distribution.s3_distribution", "tags.environment", "production"),
-					resource.TestCheckResourceAttr(
-						"aws_cloudfront_distribution.s3_distribution", "tags.account", "main"),
-				),
-			},
-			{
-				ResourceName:            "aws_cloudfront_distribution.s3_distribution",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retain_on_delete"},
-			},
-			{
-				Config: postConfig,
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCloudFrontDistributionExistence(
-						"aws_cloudfront_distribution.s3_distribution",
-					),
-					resource.TestCheckResourceAttr(
-						"aws_cloudfront_distribution.s3_distribution", "tags.%", "1"),
-					resource.TestCheckResourceAttr(
-						"aws_cloudfront_distribution.s3_distribution", "tags.environment", "dev"),
-				),
-			},
-		},
-	})
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			if actual := testSweepCloudFrontDistributionSkip(tc.tags); actual != tc.expected {
+				t.Fatalf("Expected %t, got %t", tc.expected, actual)
+			}
+		})
+	}
 }
 
-// TestAccAWSCloudFrontDistribution_customOriginruns an
-// aws_cloudfront_distribution acceptance test with a single custom origin.
-//
-// If you are testing manually and can't wait for deletion, set the
-// TF_TEST_CLOUDFRONT_RETAIN environment variable.
-func TestAccAWSCloudFrontDistribution_customOrigin(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccAWSCloudFrontDistributionCustomConfig,
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCloudFrontDistributionExistence(
-						"aws_cloudfront_distribution.custom_distribution",
-					),
-				),
-			},
-			{
-				ResourceName:            "aws_cloudfront_distribution.custom_distribution",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retain_on_delete"},
-			},
-		},
-	})
+func TestResourceAwsCloudFrontDistributionCreateDistribution_originAccessIdentityNotVisible(t *testing.T) {
+	cases := []struct {
+		label   string
+		code    string
+		message string
+	}{
+		{"no such origin access identity", cloudfront.ErrCodeNoSuchCloudFrontOriginAccessIdentity, "The specified origin access identity does not exist."},
+		{"invalid argument", cloudfront.ErrCodeInvalidArgument, "The specified origin access identity does not exist or is not valid."},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := cloudfront.New(sess)
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			attempts := 0
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				attempts++
+				if attempts == 1 {
+					r.Error = awserr.New(tc.code, tc.message, nil)
+					return
+				}
+				r.Data.(*cloudfront.CreateDistributionWithTagsOutput).Distribution = &cloudfront.Distribution{
+					Id: aws.String("E74FTE3EXAMPLE"),
+				}
+			})
+
+			output, err := resourceAwsCloudFrontDistributionCreateDistribution(conn, &cloudfront.CreateDistributionWithTagsInput{})
+			if err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+
+			if attempts != 2 {
+				t.Fatalf("Expected 2 attempts, received: %d", attempts)
+			}
+			if id := aws.StringValue(output.Distribution.Id); id != "E74FTE3EXAMPLE" {
+				t.Fatalf("Expected distribution ID %q, received: %q", "E74FTE3EXAMPLE", id)
+			}
+		})
+	}
 }
 
-// TestAccAWSCloudFrontDistribution_multiOrigin runs an
-// aws_cloudfront_distribution acceptance test with multiple origins.
-//
-// If you are testing manually and can't wait for deletion, set the
-// TF_TEST_CLOUDFRONT_RETAIN environment variable.
-func TestAccAWSCloudFrontDistribution_multiOrigin(t *testing.T) {
-	resourceName := "aws_cloudfront_distribution.multi_origin_distribution"
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccAWSCloudFrontDistributionMultiOriginConfig,
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCloudFrontDistributionExistence(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.default_ttl", "50"),
-					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.path_pattern", "images1/*.jpg"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+func TestResourceAwsCloudFrontDistributionCreateDistribution_callerReference(t *testing.T) {
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := cloudfront.New(sess)
+
+	var callerReferences []string
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		params := r.Params.(*cloudfront.CreateDistributionWithTagsInput)
+		callerReferences = append(callerReferences, aws.StringValue(params.DistributionConfigWithTags.DistributionConfig.CallerReference))
+		if len(callerReferences) == 1 {
+			r.Error = awserr.New(cloudfront.ErrCodeInvalidViewerCertificate, "The specified SSL certificate doesn't exist.", nil)
+			return
+		}
+		r.Data.(*cloudfront.CreateDistributionWithTagsOutput).Distribution = &cloudfront.Distribution{
+			Id: aws.String("E74FTE3EXAMPLE"),
+		}
+	})
+
+	input := &cloudfront.CreateDistributionWithTagsInput{
+		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
+			DistributionConfig: &cloudfront.DistributionConfig{
+				CallerReference: aws.String("2019-01-01T00:00:00.000000001Z"),
 			},
 		},
-	})
+	}
+
+	if _, err := resourceAwsCloudFrontDistributionCreateDistribution(conn, input); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	if len(callerReferences) != 2 {
+		t.Fatalf("Expected 2 attempts, received: %d", len(callerReferences))
+	}
+	if callerReferences[0] == "" || callerReferences[0] != callerReferences[1] {
+		t.Fatalf("Expected the retry to reuse the caller reference, received: %q", callerReferences)
+	}
 }
 
-// https://github.com/terraform-providers/terraform-provider-aws/issues/188
-// TestAccAWSCloudFrontDistribution_orderedCacheBehavior runs an
-// aws_cloudfront_distribution acceptance test with multiple and ordered cache behaviors.
-//
-// If you are testing manually and can't wait for deletion, set the
-// TF_TEST_CLOUDFRONT_RETAIN environment variable.
-func TestAccAWSCloudFrontDistribution_orderedCacheBehavior(t *testing.T) {
-	resourceName := "aws_cloudfront_distribution.main"
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccAWSCloudFrontDistributionOrderedCacheBehavior,
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCloudFrontDistributionExistence(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.default_ttl", "50"),
-					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.path_pattern", "images1/*.jpg"),
-					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.1.default_ttl", "51"),
-					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.1.path_pattern", "images2/*.jpg"),
-				),
+func TestResourceAwsCloudFrontDistributionCreate_tagsAccessDenied(t *testing.T) {
+	raw := func(ignoreTaggingAccessDenied bool) map[string]interface{} {
+		return map[string]interface{}{
+			"enabled":                      true,
+			"ignore_tagging_access_denied": ignoreTaggingAccessDenied,
+			"tags":                         map[string]interface{}{"environment": "production"},
+			"origin": []interface{}{
+				map[string]interface{}{
+					"origin_id":   "myCustomOrigin",
+					"domain_name": "www.example.com",
+					"custom_origin_config": []interface{}{
+						map[string]interface{}{
+							"http_port":              80,
+							"https_port":             443,
+							"origin_protocol_policy": "http-only",
+							"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+						},
+					},
+				},
 			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			"default_cache_behavior": []interface{}{
+				map[string]interface{}{
+					"allowed_methods":        []interface{}{"GET", "HEAD"},
+					"cached_methods":         []interface{}{"GET", "HEAD"},
+					"viewer_protocol_policy": "allow-all",
+					"forwarded_values": []interface{}{
+						map[string]interface{}{
+							"query_string": false,
+							"cookies": []interface{}{
+								map[string]interface{}{"forward": "none"},
+							},
+						},
+					},
+				},
 			},
-		},
+			"restrictions": []interface{}{
+				map[string]interface{}{
+					"geo_restriction": []interface{}{
+						map[string]interface{}{"restriction_type": "none"},
+					},
+				},
+			},
+			"viewer_certificate": []interface{}{
+				map[string]interface{}{"cloudfront_default_certificate": true},
+			},
+		}
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := cloudfront.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch params := r.Params.(type) {
+		case *cloudfront.CreateDistributionWithTagsInput:
+			if len(params.DistributionConfigWithTags.Tags.Items) > 0 {
+				r.Error = awserr.New(cloudfront.ErrCodeAccessDenied, "Access denied.", nil)
+				return
+			}
+			r.Data.(*cloudfront.CreateDistributionWithTagsOutput).Distribution = &cloudfront.Distribution{
+				Id: aws.String("E74FTE3EXAMPLE"),
+			}
+		case *cloudfront.GetDistributionInput:
+			r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+				ARN:              aws.String("arn:aws:cloudfront::123456789012:distribution/" + aws.StringValue(params.Id)),
+				Id:               params.Id,
+				LastModifiedTime: aws.Time(time.Now()),
+				DistributionConfig: &cloudfront.DistributionConfig{
+					DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+					Enabled:              aws.Bool(true),
+					Origins:              expandOrigins(multiOriginConf()),
+					ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+				},
+				ActiveTrustedSigners: &cloudfront.ActiveTrustedSigners{
+					Enabled: aws.Bool(false),
+				},
+			}
+		case *cloudfront.ListTagsForResourceInput:
+			r.Error = awserr.New(cloudfront.ErrCodeAccessDenied, "Access denied.", nil)
+		}
 	})
+
+	meta := &AWSClient{cloudfrontconn: conn}
+	s := resourceAwsCloudFrontDistribution().Schema
+
+	err = resourceAwsCloudFrontDistributionCreate(schema.TestResourceDataRaw(t, s, raw(false)), meta)
+	if err == nil || !strings.Contains(err.Error(), "cloudfront:TagResource") {
+		t.Fatalf("Expected error naming cloudfront:TagResource, received: %v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, s, raw(true))
+	if err := resourceAwsCloudFrontDistributionCreate(d, meta); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+	if d.Id() != "E74FTE3EXAMPLE" {
+		t.Fatalf("Expected ID %q, received: %q", "E74FTE3EXAMPLE", d.Id())
+	}
 }
 
-func TestAccAWSCloudFrontDistribution_Origin_EmptyDomainName(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config:      testAccAWSCloudFrontDistributionConfig_Origin_EmptyDomainName,
-				ExpectError: regexp.MustCompile(`domain_name must not be empty`),
+func TestResourceAwsCloudFrontDistributionCreate_arn(t *testing.T) {
+	raw := map[string]interface{}{
+		"enabled": true,
+		"origin": []interface{}{
+			map[string]interface{}{
+				"origin_id":   "myCustomOrigin",
+				"domain_name": "www.example.com",
+				"custom_origin_config": []interface{}{
+					map[string]interface{}{
+						"http_port":              80,
+						"https_port":             443,
+						"origin_protocol_policy": "http-only",
+						"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+					},
+				},
 			},
 		},
-	})
-}
-
-func TestAccAWSCloudFrontDistribution_Origin_EmptyOriginID(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config:      testAccAWSCloudFrontDistributionConfig_Origin_EmptyOriginID,
-				ExpectError: regexp.MustCompile(`origin_id must not be empty`),
+		"default_cache_behavior": []interface{}{
+			map[string]interface{}{
+				"allowed_methods":        []interface{}{"GET", "HEAD"},
+				"cached_methods":         []interface{}{"GET", "HEAD"},
+				"viewer_protocol_policy": "allow-all",
+				"forwarded_values": []interface{}{
+					map[string]interface{}{
+						"query_string": false,
+						"cookies": []interface{}{
+							map[string]interface{}{"forward": "none"},
+						},
+					},
+				},
+			},
+		},
+		"restrictions": []interface{}{
+			map[string]interface{}{
+				"geo_restriction": []interface{}{
+					map[string]interface{}{"restriction_type": "none"},
+				},
 			},
 		},
+		"viewer_certificate": []interface{}{
+			map[string]interface{}{"cloudfront_default_certificate": true},
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := cloudfront.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch r.Params.(type) {
+		case *cloudfront.CreateDistributionWithTagsInput:
+			output := r.Data.(*cloudfront.CreateDistributionWithTagsOutput)
+			output.Distribution = &cloudfront.Distribution{
+				ARN: aws.String("arn:aws:cloudfront::123456789012:distribution/E74FTE3EXAMPLE"),
+				Id:  aws.String("E74FTE3EXAMPLE"),
+			}
+			output.ETag = aws.String("E2QWRUHEXAMPLE")
+		case *cloudfront.GetDistributionInput:
+			// The ARN must not depend on reading the distribution back
+			r.Error = awserr.New("ServiceUnavailable", "Service unavailable.", nil)
+		}
 	})
-}
 
-// TestAccAWSCloudFrontDistribution_noOptionalItemsConfig runs an
-// aws_cloudfront_distribution acceptance test with no optional items set.
-//
-// If you are testing manually and can't wait for deletion, set the
-// TF_TEST_CLOUDFRONT_RETAIN environment variable.
-func TestAccAWSCloudFrontDistribution_noOptionalItemsConfig(t *testing.T) {
-	resourceName := "aws_cloudfront_distribution.no_optional_items"
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccAWSCloudFrontDistributionNoOptionalItemsConfig,
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCloudFrontDistributionExistence(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "aliases.#", "0"),
-					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:cloudfront::[^:]+:distribution/[A-Z0-9]+$`)),
-					resource.TestCheckResourceAttr(resourceName, "custom_error_response.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.allowed_methods.#", "7"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.cached_methods.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.compress", "false"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.default_ttl", "86400"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.0.cookies.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.0.cookies.0.forward", "all"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.0.cookies.0.whitelisted_names.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.0.headers.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.0.query_string", "false"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.0.query_string_cache_keys.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.lambda_function_association.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.max_ttl", "31536000"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.min_ttl", "0"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.smooth_streaming", "false"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.target_origin_id", "myCustomOrigin"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.trusted_signers.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.viewer_protocol_policy", "allow-all"),
-					resource.TestMatchResourceAttr(resourceName, "domain_name", regexp.MustCompile(`^[a-z0-9]+\.cloudfront\.net$`)),
-					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
-					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`^[A-Z0-9]+$`)),
-					resource.TestCheckResourceAttr(resourceName, "hosted_zone_id", "Z2FDTNDATAQYW2"),
-					resource.TestCheckResourceAttrSet(resourceName, "http_version"),
-					resource.TestCheckResourceAttr(resourceName, "is_ipv6_enabled", "false"),
-					resource.TestCheckResourceAttr(resourceName, "logging_config.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "origin.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_header.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.0.http_port", "80"),
-					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.0.https_port", "443"),
-					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.0.origin_keepalive_timeout", "5"),
-					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.0.origin_protocol_policy", "http-only"),
-					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.0.origin_read_timeout", "30"),
-					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.0.origin_ssl_protocols.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.domain_name", "www.example.com"),
-					resource.TestCheckResourceAttr(resourceName, "price_class", "PriceClass_All"),
-					resource.TestCheckResourceAttr(resourceName, "restrictions.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "restrictions.0.geo_restriction.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "restrictions.0.geo_restriction.0.locations.#", "4"),
-					resource.TestCheckResourceAttr(resourceName, "restrictions.0.geo_restriction.0.restriction_type", "whitelist"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
-					resource.TestCheckResourceAttr(resourceName, "viewer_certificate.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "viewer_certificate.0.cloudfront_default_certificate", "true"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retain_on_delete"},
-			},
-		},
-	})
+	d := schema.TestResourceDataRaw(t, resourceAwsCloudFrontDistribution().Schema, raw)
+	if err := resourceAwsCloudFrontDistributionCreate(d, &AWSClient{cloudfrontconn: conn}); err == nil {
+		t.Fatalf("Expected error reading the distribution")
+	}
+
+	if v := d.Get("arn").(string); v != "arn:aws:cloudfront::123456789012:distribution/E74FTE3EXAMPLE" {
+		t.Fatalf("Expected arn to be set on create, got %q", v)
+	}
+	if v := d.Get("etag").(string); v != "E2QWRUHEXAMPLE" {
+		t.Fatalf("Expected etag to be set on create, got %q", v)
+	}
 }
 
-// TestAccAWSCloudFrontDistribution_HTTP11Config runs an
-// aws_cloudfront_distribution acceptance test with the HTTP version set to
-// 1.1.
-//
-// If you are testing manually and can't wait for deletion, set the
-// TF_TEST_CLOUDFRONT_RETAIN environment variable.
-func TestAccAWSCloudFrontDistribution_HTTP11Config(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccAWSCloudFrontDistributionHTTP11Config,
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCloudFrontDistributionExistence(
-						"aws_cloudfront_distribution.http_1_1",
-					),
-				),
+func TestResourceAwsCloudFrontDistributionSslSupportMethod(t *testing.T) {
+	raw := func(sslSupportMethod string) map[string]interface{} {
+		return map[string]interface{}{
+			"enabled": true,
+			"origin": []interface{}{
+				map[string]interface{}{
+					"origin_id":   "myCustomOrigin",
+					"domain_name": "www.example.com",
+					"custom_origin_config": []interface{}{
+						map[string]interface{}{
+							"http_port":              80,
+							"https_port":             443,
+							"origin_protocol_policy": "http-only",
+							"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+						},
+					},
+				},
 			},
-			{
-				ResourceName:            "aws_cloudfront_distribution.http_1_1",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			"default_cache_behavior": []interface{}{
+				map[string]interface{}{
+					"allowed_methods":        []interface{}{"GET", "HEAD"},
+					"cached_methods":         []interface{}{"GET", "HEAD"},
+					"target_origin_id":       "myCustomOrigin",
+					"viewer_protocol_policy": "allow-all",
+					"forwarded_values": []interface{}{
+						map[string]interface{}{
+							"query_string": false,
+							"cookies": []interface{}{
+								map[string]interface{}{"forward": "none"},
+							},
+						},
+					},
+				},
 			},
-		},
-	})
+			"restrictions": []interface{}{
+				map[string]interface{}{
+					"geo_restriction": []interface{}{
+						map[string]interface{}{"restriction_type": "none"},
+					},
+				},
+			},
+			"viewer_certificate": []interface{}{
+				map[string]interface{}{
+					"acm_certificate_arn": "arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012",
+					"ssl_support_method":  sslSupportMethod,
+				},
+			},
+		}
+	}
+	resourceConfig := func(sslSupportMethod string) *terraform.ResourceConfig {
+		rc, err := config.NewRawConfig(raw(sslSupportMethod))
+		if err != nil {
+			t.Fatalf("Error new raw config: %s", err)
+		}
+		return terraform.NewResourceConfig(rc)
+	}
+
+	r := resourceAwsCloudFrontDistribution()
+
+	for v, warnCount := range map[string]int{"sni-only": 0, "vip": 1} {
+		ws, errs := r.Validate(resourceConfig(v))
+		if len(errs) != 0 {
+			t.Fatalf("Expected %q to be a valid ssl_support_method, received: %v", v, errs)
+		}
+		if len(ws) != warnCount {
+			t.Fatalf("Expected %d warnings for ssl_support_method %q, received: %v", warnCount, v, ws)
+		}
+	}
+	if _, errs := r.Validate(resourceConfig("static-ip")); len(errs) == 0 {
+		t.Fatalf("Expected %q to be an invalid ssl_support_method", "static-ip")
+	}
+
+	// Switching between sni-only and vip updates the distribution in place
+	for _, tc := range [][2]string{{"sni-only", "vip"}, {"vip", "sni-only"}} {
+		d := schema.TestResourceDataRaw(t, r.Schema, raw(tc[0]))
+		d.SetId("E74FTE3EXAMPLE")
+
+		diff, err := r.Diff(d.State(), resourceConfig(tc[1]), nil)
+		if err != nil {
+			t.Fatalf("Expected no error, received: %s", err)
+		}
+		if diff.RequiresNew() {
+			t.Fatalf("Expected changing ssl_support_method from %q to %q not to require a new distribution", tc[0], tc[1])
+		}
+		if attr, ok := diff.Attributes["viewer_certificate.0.ssl_support_method"]; !ok || attr.New != tc[1] {
+			t.Fatalf("Expected ssl_support_method to change to %q, received: %#v", tc[1], attr)
+		}
+	}
 }
 
-func TestAccAWSCloudFrontDistribution_IsIPV6EnabledConfig(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccAWSCloudFrontDistributionIsIPV6EnabledConfig,
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCloudFrontDistributionExistence(
-						"aws_cloudfront_distribution.is_ipv6_enabled",
-					),
-					resource.TestCheckResourceAttr(
-						"aws_cloudfront_distribution.is_ipv6_enabled", "is_ipv6_enabled", "true"),
-				),
+func TestResourceAwsCloudFrontDistributionCreate_defaultTags(t *testing.T) {
+	raw := map[string]interface{}{
+		"enabled": true,
+		"origin": []interface{}{
+			map[string]interface{}{
+				"origin_id":   "myCustomOrigin",
+				"domain_name": "www.example.com",
+				"custom_origin_config": []interface{}{
+					map[string]interface{}{
+						"http_port":              80,
+						"https_port":             443,
+						"origin_protocol_policy": "http-only",
+						"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+					},
+				},
 			},
-			{
-				ResourceName:            "aws_cloudfront_distribution.is_ipv6_enabled",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+		},
+		"default_cache_behavior": []interface{}{
+			map[string]interface{}{
+				"allowed_methods":        []interface{}{"GET", "HEAD"},
+				"cached_methods":         []interface{}{"GET", "HEAD"},
+				"target_origin_id":       "myCustomOrigin",
+				"viewer_protocol_policy": "allow-all",
+				"forwarded_values": []interface{}{
+					map[string]interface{}{
+						"query_string": false,
+						"cookies": []interface{}{
+							map[string]interface{}{"forward": "none"},
+						},
+					},
+				},
+			},
+		},
+		"restrictions": []interface{}{
+			map[string]interface{}{
+				"geo_restriction": []interface{}{
+					map[string]interface{}{"restriction_type": "none"},
+				},
 			},
 		},
+		"viewer_certificate": []interface{}{
+			map[string]interface{}{"cloudfront_default_certificate": true},
+		},
+		"tags": map[string]interface{}{
+			"Name": "example",
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	var created *cloudfront.DistributionConfigWithTags
+	conn := cloudfront.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch params := r.Params.(type) {
+		case *cloudfront.CreateDistributionWithTagsInput:
+			created = params.DistributionConfigWithTags
+			output := r.Data.(*cloudfront.CreateDistributionWithTagsOutput)
+			output.Distribution = &cloudfront.Distribution{
+				ARN: aws.String("arn:aws:cloudfront::123456789012:distribution/E74FTE3EXAMPLE"),
+				Id:  aws.String("E74FTE3EXAMPLE"),
+			}
+			output.ETag = aws.String("E2QWRUHEXAMPLE")
+		case *cloudfront.GetDistributionInput:
+			r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+				ARN:                aws.String("arn:aws:cloudfront::123456789012:distribution/E74FTE3EXAMPLE"),
+				Id:                 params.Id,
+				LastModifiedTime:   aws.Time(time.Now()),
+				DistributionConfig: created.DistributionConfig,
+				ActiveTrustedSigners: &cloudfront.ActiveTrustedSigners{
+					Enabled: aws.Bool(false),
+				},
+			}
+			r.Data.(*cloudfront.GetDistributionOutput).ETag = aws.String("E2QWRUHEXAMPLE")
+		case *cloudfront.ListTagsForResourceInput:
+			r.Data.(*cloudfront.ListTagsForResourceOutput).Tags = created.Tags
+		}
 	})
+
+	meta := &AWSClient{
+		cloudfrontconn: conn,
+		cloudfrontDefaultTags: map[string]interface{}{
+			"ManagedBy": "terraform",
+		},
+	}
+	r := resourceAwsCloudFrontDistribution()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	if err := resourceAwsCloudFrontDistributionCreate(d, meta); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	expectedTags := map[string]string{"ManagedBy": "terraform", "Name": "example"}
+	if tags := tagsToMapCloudFront(created.Tags); !reflect.DeepEqual(tags, expectedTags) {
+		t.Fatalf("Expected distribution to be created with tags %v, received: %v", expectedTags, tags)
+	}
+	if tags := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(tags, raw["tags"]) {
+		t.Fatalf("Expected tags %v in state, received: %v", raw["tags"], tags)
+	}
+	expectedTagsAll := map[string]interface{}{"ManagedBy": "terraform", "Name": "example"}
+	if tags := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(tags, expectedTagsAll) {
+		t.Fatalf("Expected tags_all %v in state, received: %v", expectedTagsAll, tags)
+	}
+
+	rc, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("Error new raw config: %s", err)
+	}
+	diff, err := r.Diff(d.State(), terraform.NewResourceConfig(rc), meta)
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("Expected no difference after create, received: %#v", diff.Attributes)
+	}
 }
 
-func TestAccAWSCloudFrontDistribution_noCustomErrorResponseConfig(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccAWSCloudFrontDistributionNoCustomErroResponseInfo,
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCloudFrontDistributionExistence(
-						"aws_cloudfront_distribution.no_custom_error_responses",
-					),
-				),
+func TestResourceAwsCloudFrontDistributionCustomizeDiffTagsAll(t *testing.T) {
+	raw := func(tags map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"enabled": true,
+			"origin": []interface{}{
+				map[string]interface{}{
+					"origin_id":   "myCustomOrigin",
+					"domain_name": "www.example.com",
+					"custom_origin_config": []interface{}{
+						map[string]interface{}{
+							"http_port":              80,
+							"https_port":             443,
+							"origin_protocol_policy": "http-only",
+							"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+						},
+					},
+				},
 			},
-			{
-				ResourceName:            "aws_cloudfront_distribution.no_custom_error_responses",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			"default_cache_behavior": []interface{}{
+				map[string]interface{}{
+					"allowed_methods":        []interface{}{"GET", "HEAD"},
+					"cached_methods":         []interface{}{"GET", "HEAD"},
+					"target_origin_id":       "myCustomOrigin",
+					"viewer_protocol_policy": "allow-all",
+					"forwarded_values": []interface{}{
+						map[string]interface{}{
+							"query_string": false,
+							"cookies": []interface{}{
+								map[string]interface{}{"forward": "none"},
+							},
+						},
+					},
+				},
+			},
+			"restrictions": []interface{}{
+				map[string]interface{}{
+					"geo_restriction": []interface{}{
+						map[string]interface{}{"restriction_type": "none"},
+					},
+				},
 			},
+			"viewer_certificate": []interface{}{
+				map[string]interface{}{"cloudfront_default_certificate": true},
+			},
+			"tags": tags,
+		}
+	}
+
+	r := resourceAwsCloudFrontDistribution()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw(map[string]interface{}{"Name": "example"}))
+	d.SetId("E74FTE3EXAMPLE")
+	d.Set("tags_all", map[string]interface{}{"ManagedBy": "terraform", "Name": "example"})
+
+	rc, err := config.NewRawConfig(raw(map[string]interface{}{"Name": "example", "Environment": "test"}))
+	if err != nil {
+		t.Fatalf("Error new raw config: %s", err)
+	}
+	meta := &AWSClient{
+		cloudfrontDefaultTags: map[string]interface{}{
+			"ManagedBy": "terraform",
 		},
-	})
-}
+	}
+	diff, err := r.Diff(d.State(), terraform.NewResourceConfig(rc), meta)
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
 
-func testAccCheckCloudFrontDistributionDestroy(s *terraform.State) error {
-	for k, rs := range s.RootModule().Resources {
-		if rs.Type != "aws_cloudfront_distribution" {
-			continue
-		}
-		dist, err := testAccAuxCloudFrontGetDistributionConfig(s, k)
-		if err == nil {
-			if _, ok := os.LookupEnv("TF_TEST_CLOUDFRONT_RETAIN"); ok {
-				if *dist.DistributionConfig.Enabled {
-					return fmt.Errorf("CloudFront distribution should be disabled")
-				}
-				return nil
-			}
-			return fmt.Errorf("CloudFront distribution did not destroy")
+	tags := make(map[string]string)
+	for k, attr := range diff.Attributes {
+		if strings.HasPrefix(k, "tags.") && k != "tags.%" {
+			tags[strings.TrimPrefix(k, "tags.")] = attr.New
 		}
 	}
-	return nil
-}
 
-func testAccCheckCloudFrontDistributionExistence(cloudFrontResource string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		_, err := testAccAuxCloudFrontGetDistributionConfig(s, cloudFrontResource)
-
-		return err
+	// Only the new resource tag is planned for tags, while tags_all also
+	// plans the default tag that is already on the distribution.
+	if expected := map[string]string{"Environment": "test"}; !reflect.DeepEqual(tags, expected) {
+		t.Fatalf("Expected tags changes %v, received: %v", expected, tags)
+	}
+	if attr, ok := diff.Attributes["tags_all.Environment"]; !ok || attr.New != "test" {
+		t.Fatalf("Expected tags_all to add Environment, received: %#v", diff.Attributes)
+	}
+	if attr, ok := diff.Attributes["tags_all.ManagedBy"]; ok && attr.New != "terraform" {
+		t.Fatalf("Expected tags_all to keep ManagedBy, received: %#v", attr)
+	}
+	if _, ok := diff.Attributes["tags.ManagedBy"]; ok {
+		t.Fatalf("Expected tags not to include the default tag, received: %#v", diff.Attributes)
 	}
 }
 
-func testAccAuxCloudFrontGetDistributionConfig(s *terraform.State, cloudFrontResource string) (*cloudfront.Distribution, error) {
-	cf, ok := s.RootModule().Resources[cloudFrontResource]
-	if !ok {
-		return nil, fmt.Errorf("Not found: %s", cloudFrontResource)
+func TestCloudFrontTagsDefaults(t *testing.T) {
+	defaultTags := map[string]interface{}{
+		"ManagedBy": "terraform",
+		"Team":      "platform",
 	}
 
-	if cf.Primary.ID == "" {
-		return nil, fmt.Errorf("No Id is set")
+	merged := tagsWithDefaultsCloudFront(defaultTags, map[string]interface{}{
+		"Name": "example",
+		"Team": "web",
+	})
+	expectedMerged := map[string]interface{}{
+		"ManagedBy": "terraform",
+		"Name":      "example",
+		"Team":      "web",
+	}
+	if !reflect.DeepEqual(merged, expectedMerged) {
+		t.Fatalf("Expected merged tags %v, received: %v", expectedMerged, merged)
 	}
 
-	cloudfrontconn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+	cases := []struct {
+		label      string
+		tags       map[string]string
+		configured map[string]interface{}
+		expected   map[string]string
+	}{
+		{
+			"default hidden",
+			map[string]string{"ManagedBy": "terraform", "Name": "example"},
+			map[string]interface{}{"Name": "example"},
+			map[string]string{"Name": "example"},
+		},
+		{
+			"default overridden",
+			map[string]string{"ManagedBy": "terraform", "Team": "web"},
+			map[string]interface{}{"Team": "web"},
+			map[string]string{"Team": "web"},
+		},
+		{
+			"default configured",
+			map[string]string{"ManagedBy": "terraform"},
+			map[string]interface{}{"ManagedBy": "terraform"},
+			map[string]string{"ManagedBy": "terraform"},
+		},
+		{
+			"default changed outside of terraform",
+			map[string]string{"ManagedBy": "console"},
+			nil,
+			map[string]string{"ManagedBy": "console"},
+		},
+	}
 
-	req := &cloudfront.GetDistributionInput{
-		Id: aws.String(cf.Primary.ID),
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			tags := tagsWithoutDefaultsCloudFront(defaultTags, tc.tags, tc.configured)
+			if !reflect.DeepEqual(tags, tc.expected) {
+				t.Fatalf("Expected tags %v, received: %v", tc.expected, tags)
+			}
+		})
 	}
+}
 
-	res, err := cloudfrontconn.GetDistribution(req)
+func TestResourceAwsCloudFrontDistributionCreateDistribution_invalidArgument(t *testing.T) {
+	sess, err := session.NewSession(nil)
 	if err != nil {
-		return nil, fmt.Errorf("Error retrieving CloudFront distribution: %s", err)
+		t.Fatalf("Error new session: %s", err)
 	}
 
-	return res.Distribution, nil
-}
+	conn := cloudfront.New(sess)
 
-func testAccAWSCloudFrontDistributionRetainConfig() string {
-	if _, ok := os.LookupEnv("TF_TEST_CLOUDFRONT_RETAIN"); ok {
-		return "retain_on_delete = true"
+	attempts := 0
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		attempts++
+		r.Error = awserr.New(cloudfront.ErrCodeInvalidArgument, "The parameter Origin DomainName does not refer to a valid S3 bucket.", nil)
+	})
+
+	_, err = resourceAwsCloudFrontDistributionCreateDistribution(conn, &cloudfront.CreateDistributionWithTagsInput{})
+	if !isAWSErr(err, cloudfront.ErrCodeInvalidArgument, "") {
+		t.Fatalf("Expected InvalidArgument error, received: %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("Expected 1 attempt, received: %d", attempts)
 	}
-	return ""
 }
 
-var originBucket = fmt.Sprintf(`
-resource "aws_s3_bucket" "s3_bucket_origin" {
-	bucket = "mybucket.${var.rand_id}"
-	acl = "public-read"
-}
-`)
-
-var logBucket = fmt.Sprintf(`
-resource "aws_s3_bucket" "s3_bucket_logs" {
-	bucket = "mylogs.${var.rand_id}"
-	acl = "public-read"
-}
-`)
+func TestResourceAwsCloudFrontDistributionImport_tags(t *testing.T) {
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
 
-var testAccAWSCloudFrontDistributionS3Config = `
-variable rand_id {
-	default = %d
-}
+	conn := cloudfront.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch params := r.Params.(type) {
+		case *cloudfront.GetDistributionInput:
+			r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+				ARN: aws.String("arn:aws:cloudfront::123456789012:distribution/" + aws.StringValue(params.Id)),
+				Id:  params.Id,
+				DistributionConfig: &cloudfront.DistributionConfig{
+					DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+					Enabled:              aws.Bool(true),
+					Origins:              expandOrigins(multiOriginConf()),
+					ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+				},
+			}
+		case *cloudfront.ListTagsForResourceInput:
+			if aws.StringValue(params.Resource) != "arn:aws:cloudfront::123456789012:distribution/E74FTE3EXAMPLE" {
+				r.Error = awserr.New(cloudfront.ErrCodeNoSuchResource, "unexpected ARN", nil)
+				return
+			}
+			r.Data.(*cloudfront.ListTagsForResourceOutput).Tags = &cloudfront.Tags{
+				Items: []*cloudfront.Tag{
+					{Key: aws.String("environment"), Value: aws.String("production")},
+				},
+			}
+		}
+	})
 
-# origin bucket
-%s
+	d := resourceAwsCloudFrontDistribution().Data(nil)
+	d.SetId("E74FTE3EXAMPLE")
 
-# log bucket
-%s
+	results, err := resourceAwsCloudFrontDistributionImport(d, &AWSClient{cloudfrontconn: conn})
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
 
-resource "aws_cloudfront_distribution" "s3_distribution" {
-	origin {
-		domain_name = "${aws_s3_bucket.s3_bucket_origin.id}.s3.amazonaws.com"
-		origin_id = "myS3Origin"
+	expected := map[string]interface{}{"environment": "production"}
+	if tags := results[0].Get("tags").(map[string]interface{}); !reflect.DeepEqual(tags, expected) {
+		t.Fatalf("Received tags: %#v\nExpected: %#v\n", tags, expected)
 	}
-	enabled = true
-	default_root_object = "index.html"
-	logging_config {
-		include_cookies = false
-		bucket = "${aws_s3_bucket.s3_bucket_logs.id}.s3.amazonaws.com"
-		prefix = "myprefix"
+}
+
+func TestResourceAwsCloudFrontDistributionImport_minimumProtocolVersion(t *testing.T) {
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
 	}
-	aliases = [ "mysite.${var.rand_id}.example.com", "yoursite.${var.rand_id}.example.com" ]
-	default_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myS3Origin"
-		forwarded_values {
-			query_string = false
-			cookies {
-				forward = "none"
+
+	conn := cloudfront.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch params := r.Params.(type) {
+		case *cloudfront.GetDistributionInput:
+			viewerCertificate := expandViewerCertificate(viewerCertificateConfSetACM())
+			viewerCertificate.MinimumProtocolVersion = aws.String("TLSv1.2_2018")
+			r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+				ARN: aws.String("arn:aws:cloudfront::123456789012:distribution/" + aws.StringValue(params.Id)),
+				Id:  params.Id,
+				DistributionConfig: &cloudfront.DistributionConfig{
+					DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+					Enabled:              aws.Bool(true),
+					Origins:              expandOrigins(multiOriginConf()),
+					ViewerCertificate:    viewerCertificate,
+				},
 			}
+		case *cloudfront.ListTagsForResourceInput:
+			r.Data.(*cloudfront.ListTagsForResourceOutput).Tags = &cloudfront.Tags{}
 		}
-		viewer_protocol_policy = "allow-all"
-		min_ttl = 0
-		default_ttl = 3600
-		max_ttl = 86400
-	}
-	price_class = "PriceClass_200"
-	restrictions {
-		geo_restriction {
-			restriction_type = "whitelist"
-			locations = [ "US", "CA", "GB", "DE" ]
-		}
+	})
+
+	d := resourceAwsCloudFrontDistribution().Data(nil)
+	d.SetId("E74FTE3EXAMPLE")
+
+	results, err := resourceAwsCloudFrontDistributionImport(d, &AWSClient{cloudfrontconn: conn})
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
 	}
-	viewer_certificate {
-		cloudfront_default_certificate = true
+
+	if v := results[0].Get("viewer_certificate.0.minimum_protocol_version").(string); v != "TLSv1.2_2018" {
+		t.Fatalf("Expected minimum_protocol_version to be TLSv1.2_2018, got %q", v)
 	}
-	%s
 }
-`
 
-var testAccAWSCloudFrontDistributionS3ConfigWithTags = `
-variable rand_id {
-	default = %d
+func TestResourceAwsCloudFrontDistributionImport_iamCertificate(t *testing.T) {
+	raw := map[string]interface{}{
+		"enabled": true,
+		"aliases": []interface{}{"www.example.com"},
+		"origin": []interface{}{
+			map[string]interface{}{
+				"origin_id":   "myCustomOrigin",
+				"domain_name": "origin.example.com",
+				"custom_origin_config": []interface{}{
+					map[string]interface{}{
+						"http_port":              80,
+						"https_port":             443,
+						"origin_protocol_policy": "https-only",
+						"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+					},
+				},
+			},
+		},
+		"default_cache_behavior": []interface{}{
+			map[string]interface{}{
+				"allowed_methods":        []interface{}{"GET", "HEAD"},
+				"cached_methods":         []interface{}{"GET", "HEAD"},
+				"target_origin_id":       "myCustomOrigin",
+				"viewer_protocol_policy": "redirect-to-https",
+				"forwarded_values": []interface{}{
+					map[string]interface{}{
+						"query_string": false,
+						"cookies": []interface{}{
+							map[string]interface{}{"forward": "none"},
+						},
+					},
+				},
+			},
+		},
+		"restrictions": []interface{}{
+			map[string]interface{}{
+				"geo_restriction": []interface{}{
+					map[string]interface{}{"restriction_type": "none"},
+				},
+			},
+		},
+		"viewer_certificate": []interface{}{
+			map[string]interface{}{
+				"iam_certificate_id":       "ASCAJRRE5XYF52TKRY5M4",
+				"minimum_protocol_version": "TLSv1.2_2018",
+				"ssl_support_method":       "sni-only",
+			},
+		},
+	}
+
+	r := resourceAwsCloudFrontDistribution()
+	rc, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("Error new raw config: %s", err)
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+	conn := cloudfront.New(sess)
+
+	cases := []struct {
+		label             string
+		iamCertificateId  *string
+		certificate       *string
+		certificateSource *string
+	}{
+		// As returned by CloudFront, with the deprecated Certificate and
+		// CertificateSource fields alongside IAMCertificateId
+		{"iam certificate", aws.String("ASCAJRRE5XYF52TKRY5M4"), aws.String("ASCAJRRE5XYF52TKRY5M4"), aws.String(cloudfront.CertificateSourceIam)},
+		{"legacy certificate fields", nil, aws.String("ASCAJRRE5XYF52TKRY5M4"), aws.String(cloudfront.CertificateSourceIam)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			distributionConfig := expandDistributionConfig(schema.TestResourceDataRaw(t, r.Schema, raw))
+			distributionConfig.ViewerCertificate.IAMCertificateId = tc.iamCertificateId
+			distributionConfig.ViewerCertificate.Certificate = tc.certificate
+			distributionConfig.ViewerCertificate.CertificateSource = tc.certificateSource
+			distributionConfig.ViewerCertificate.CloudFrontDefaultCertificate = aws.Bool(false)
+
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch params := r.Params.(type) {
+				case *cloudfront.GetDistributionInput:
+					r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+						ARN:                aws.String("arn:aws:cloudfront::123456789012:distribution/" + aws.StringValue(params.Id)),
+						Id:                 params.Id,
+						DistributionConfig: distributionConfig,
+					}
+				case *cloudfront.ListTagsForResourceInput:
+					r.Data.(*cloudfront.ListTagsForResourceOutput).Tags = &cloudfront.Tags{}
+				}
+			})
+
+			d := r.Data(nil)
+			d.SetId("E74FTE3EXAMPLE")
+
+			results, err := resourceAwsCloudFrontDistributionImport(d, &AWSClient{cloudfrontconn: conn})
+			if err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+
+			if v := results[0].Get("viewer_certificate.0.certificate_source").(string); v != cloudfront.CertificateSourceIam {
+				t.Fatalf("Expected certificate_source %q, got %q", cloudfront.CertificateSourceIam, v)
+			}
+
+			diff, err := r.Diff(results[0].State(), terraform.NewResourceConfig(rc), nil)
+			if err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			for k, attr := range diff.Attributes {
+				if strings.HasPrefix(k, "viewer_certificate.") || strings.HasPrefix(k, "aliases.") {
+					t.Fatalf("Expected no difference in %s after import, received: %#v", k, attr)
+				}
+			}
+		})
+	}
 }
 
-# origin bucket
-%s
+func TestResourceAwsCloudFrontDistributionRead_notFound(t *testing.T) {
+	cases := []struct {
+		label            string
+		newResource      bool
+		notFoundCalls    int
+		tagsErr          error
+		expectedGetCalls int
+		expectedInState  bool
+	}{
+		{"new resource transient not found", true, 1, nil, 2, true},
+		{"existing resource not found", false, -1, nil, 1, false},
+		{"tags not found", false, 0, awserr.New(cloudfront.ErrCodeNoSuchResource, "The specified distribution does not exist.", nil), 1, false},
+	}
 
-# log bucket
-%s
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			sess, err := session.NewSession(nil)
+			if err != nil {
+				t.Fatalf("Error new session: %s", err)
+			}
 
-resource "aws_cloudfront_distribution" "s3_distribution" {
-	origin {
-		domain_name = "${aws_s3_bucket.s3_bucket_origin.id}.s3.amazonaws.com"
-		origin_id = "myS3Origin"
+			var getCalls int
+			conn := cloudfront.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch params := r.Params.(type) {
+				case *cloudfront.GetDistributionInput:
+					getCalls++
+					if tc.notFoundCalls < 0 || getCalls <= tc.notFoundCalls {
+						r.Error = awserr.New(cloudfront.ErrCodeNoSuchDistribution, "The specified distribution does not exist.", nil)
+						return
+					}
+					r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+						ARN: aws.String("arn:aws:cloudfront::123456789012:distribution/" + aws.StringValue(params.Id)),
+						Id:  params.Id,
+						DistributionConfig: &cloudfront.DistributionConfig{
+							DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+							Enabled:              aws.Bool(true),
+							Origins:              expandOrigins(multiOriginConf()),
+							ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+						},
+					}
+				case *cloudfront.ListTagsForResourceInput:
+					if tc.tagsErr != nil {
+						r.Error = tc.tagsErr
+						return
+					}
+					r.Data.(*cloudfront.ListTagsForResourceOutput).Tags = &cloudfront.Tags{}
+				}
+			})
+
+			d := resourceAwsCloudFrontDistribution().Data(nil)
+			d.SetId("E74FTE3EXAMPLE")
+			if tc.newResource {
+				d.MarkNewResource()
+			}
+
+			if err := resourceAwsCloudFrontDistributionRead(d, &AWSClient{cloudfrontconn: conn}); err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if getCalls != tc.expectedGetCalls {
+				t.Fatalf("Expected %d GetDistribution calls, got %d", tc.expectedGetCalls, getCalls)
+			}
+			if inState := d.Id() != ""; inState != tc.expectedInState {
+				t.Fatalf("Expected distribution in state to be %t, got %t", tc.expectedInState, inState)
+			}
+		})
 	}
-	enabled = true
-	default_root_object = "index.html"
-	aliases = [ "mysite.${var.rand_id}.example.com", "yoursite.${var.rand_id}.example.com" ]
-	default_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myS3Origin"
-		forwarded_values {
-			query_string = false
-			cookies {
-				forward = "none"
+}
+
+func TestResourceAwsCloudFrontDistributionImport_domainName(t *testing.T) {
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := cloudfront.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch params := r.Params.(type) {
+		case *cloudfront.ListDistributionsInput:
+			output := r.Data.(*cloudfront.ListDistributionsOutput)
+			if params.Marker == nil {
+				output.DistributionList = &cloudfront.DistributionList{
+					IsTruncated: aws.Bool(true),
+					NextMarker:  aws.String("E1UXSJ8EXAMPLE"),
+					Items: []*cloudfront.DistributionSummary{
+						{Id: aws.String("E1UXSJ8EXAMPLE"), DomainName: aws.String("d222222abcdef8.cloudfront.net")},
+					},
+				}
+				return
 			}
+			output.DistributionList = &cloudfront.DistributionList{
+				IsTruncated: aws.Bool(false),
+				Items: []*cloudfront.DistributionSummary{
+					{Id: aws.String("E74FTE3EXAMPLE"), DomainName: aws.String("d111111abcdef8.cloudfront.net")},
+				},
+			}
+		case *cloudfront.GetDistributionInput:
+			r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+				ARN: aws.String("arn:aws:cloudfront::123456789012:distribution/" + aws.StringValue(params.Id)),
+				Id:  params.Id,
+				DistributionConfig: &cloudfront.DistributionConfig{
+					DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+					Enabled:              aws.Bool(true),
+					Origins:              expandOrigins(multiOriginConf()),
+					ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+				},
+			}
+		case *cloudfront.ListTagsForResourceInput:
+			r.Data.(*cloudfront.ListTagsForResourceOutput).Tags = &cloudfront.Tags{}
 		}
-		viewer_protocol_policy = "allow-all"
-		min_ttl = 0
-		default_ttl = 3600
-		max_ttl = 86400
+	})
+
+	meta := &AWSClient{cloudfrontconn: conn}
+
+	d := resourceAwsCloudFrontDistribution().Data(nil)
+	d.SetId("D111111ABCDEF8.cloudfront.net")
+
+	results, err := resourceAwsCloudFrontDistributionImport(d, meta)
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
 	}
-	price_class = "PriceClass_200"
-	restrictions {
-		geo_restriction {
-			restriction_type = "whitelist"
-			locations = [ "US", "CA", "GB", "DE" ]
+	if id := results[0].Id(); id != "E74FTE3EXAMPLE" {
+		t.Fatalf("Expected ID %q, received: %q", "E74FTE3EXAMPLE", id)
+	}
+
+	d = resourceAwsCloudFrontDistribution().Data(nil)
+	d.SetId("d333333abcdef8.cloudfront.net")
+
+	_, err = resourceAwsCloudFrontDistributionImport(d, meta)
+	if err == nil || err.Error() != `no CloudFront Distribution found with domain name "d333333abcdef8.cloudfront.net"` {
+		t.Fatalf("Expected not found error, received: %v", err)
+	}
+}
+
+func TestResourceAwsCloudFrontDistributionExpand_loggingConfigOnly(t *testing.T) {
+	raw := func(includeCookies bool) map[string]interface{} {
+		return map[string]interface{}{
+			"caller_reference":    "2019-01-01T00:00:00Z",
+			"comment":             "some comment",
+			"default_root_object": "index.html",
+			"enabled":             true,
+			"aliases":             []interface{}{"www.example.com"},
+			"origin": []interface{}{
+				map[string]interface{}{
+					"origin_id":   "myCustomOrigin",
+					"domain_name": "www.example.com",
+					"custom_origin_config": []interface{}{
+						map[string]interface{}{
+							"http_port":              80,
+							"https_port":             443,
+							"origin_protocol_policy": "http-only",
+							"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+						},
+					},
+				},
+			},
+			"default_cache_behavior": []interface{}{
+				map[string]interface{}{
+					"allowed_methods":        []interface{}{"GET", "HEAD"},
+					"cached_methods":         []interface{}{"GET", "HEAD"},
+					"target_origin_id":       "myCustomOrigin",
+					"viewer_protocol_policy": "allow-all",
+					"forwarded_values": []interface{}{
+						map[string]interface{}{
+							"query_string": true,
+							"headers":      []interface{}{"Origin"},
+							"cookies": []interface{}{
+								map[string]interface{}{
+									"forward":           "whitelist",
+									"whitelisted_names": []interface{}{"session"},
+								},
+							},
+						},
+					},
+				},
+			},
+			"logging_config": []interface{}{
+				map[string]interface{}{
+					"bucket":          "mylogs.s3.amazonaws.com",
+					"prefix":          "myprefix",
+					"include_cookies": includeCookies,
+				},
+			},
+			"restrictions": []interface{}{
+				map[string]interface{}{
+					"geo_restriction": []interface{}{
+						map[string]interface{}{
+							"restriction_type": "whitelist",
+							"locations":        []interface{}{"US", "CA"},
+						},
+					},
+				},
+			},
+			"viewer_certificate": []interface{}{
+				map[string]interface{}{"cloudfront_default_certificate": true},
+			},
 		}
 	}
-	viewer_certificate {
-		cloudfront_default_certificate = true
+
+	s := resourceAwsCloudFrontDistribution().Schema
+	before := expandDistributionConfig(schema.TestResourceDataRaw(t, s, raw(false)))
+	after := expandDistributionConfig(schema.TestResourceDataRaw(t, s, raw(true)))
+
+	if !aws.BoolValue(after.Logging.IncludeCookies) {
+		t.Fatalf("Expected Logging.IncludeCookies to be true, got %v", after.Logging.IncludeCookies)
 	}
-	tags = {
-            environment = "production"
-            account = "main"
+
+	// Only the logging configuration may differ
+	after.Logging.IncludeCookies = aws.Bool(false)
+	if !reflect.DeepEqual(before, after) {
+		t.Fatalf("Expected only Logging.IncludeCookies to change\nBefore: %s\nAfter: %s", before, after)
 	}
-	%s
-}
-`
 
-var testAccAWSCloudFrontDistributionS3ConfigWithTagsUpdated = `
-variable rand_id {
-	default = %d
+	// Reading the configuration back must not lose any fields that would be
+	// reset by the next update
+	d := resourceAwsCloudFrontDistribution().Data(nil)
+	if err := flattenDistributionConfig(d, before); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+	if roundTrip := expandDistributionConfig(d); !reflect.DeepEqual(before, roundTrip) {
+		t.Fatalf("Expected configuration to survive flatten and expand\nExpected: %s\nReceived: %s", before, roundTrip)
+	}
 }
 
-# origin bucket
-%s
+func TestResourceAwsCloudFrontDistributionExpand_defaultTargetOriginId(t *testing.T) {
+	origin := func(id string) map[string]interface{} {
+		return map[string]interface{}{
+			"origin_id":   id,
+			"domain_name": id + ".example.com",
+			"custom_origin_config": []interface{}{
+				map[string]interface{}{
+					"http_port":              80,
+					"https_port":             443,
+					"origin_protocol_policy": "http-only",
+					"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+				},
+			},
+		}
+	}
+	raw := func(targetOriginId string, origins ...interface{}) map[string]interface{} {
+		defaultCacheBehavior := map[string]interface{}{
+			"allowed_methods":        []interface{}{"GET", "HEAD"},
+			"cached_methods":         []interface{}{"GET", "HEAD"},
+			"viewer_protocol_policy": "allow-all",
+			"forwarded_values": []interface{}{
+				map[string]interface{}{
+					"query_string": false,
+					"cookies": []interface{}{
+						map[string]interface{}{"forward": "none"},
+					},
+				},
+			},
+		}
+		if targetOriginId != "" {
+			defaultCacheBehavior["target_origin_id"] = targetOriginId
+		}
 
-# log bucket
-%s
+		return map[string]interface{}{
+			"enabled":                true,
+			"origin":                 origins,
+			"default_cache_behavior": []interface{}{defaultCacheBehavior},
+			"restrictions": []interface{}{
+				map[string]interface{}{
+					"geo_restriction": []interface{}{
+						map[string]interface{}{"restriction_type": "none"},
+					},
+				},
+			},
+			"viewer_certificate": []interface{}{
+				map[string]interface{}{"cloudfront_default_certificate": true},
+			},
+		}
+	}
 
-resource "aws_cloudfront_distribution" "s3_distribution" {
-	origin {
-		domain_name = "${aws_s3_bucket.s3_bucket_origin.id}.s3.amazonaws.com"
-		origin_id = "myS3Origin"
+	cases := []struct {
+		label    string
+		raw      map[string]interface{}
+		expected string
+		err      string
+	}{
+		{"single origin inferred", raw("", origin("primary")), "primary", ""},
+		{"single origin explicit", raw("primary", origin("primary")), "primary", ""},
+		{"multiple origins explicit", raw("secondary", origin("primary"), origin("secondary")), "secondary", ""},
+		{"multiple origins omitted", raw("", origin("primary"), origin("secondary")), "", "default_cache_behavior: target_origin_id must be set when the distribution has 2 origins"},
 	}
-	enabled = true
-	default_root_object = "index.html"
-	aliases = [ "mysite.${var.rand_id}.example.com", "yoursite.${var.rand_id}.example.com" ]
-	default_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myS3Origin"
-		forwarded_values {
-			query_string = false
-			cookies {
-				forward = "none"
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceAwsCloudFrontDistribution().Schema, tc.raw)
+
+			err := validateCloudFrontDistributionDefaultTargetOriginId(d)
+			if tc.err == "" && err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("Expected error %q, received: %v", tc.err, err)
+				}
+				return
 			}
+
+			distributionConfig := expandDistributionConfig(d)
+			if v := aws.StringValue(distributionConfig.DefaultCacheBehavior.TargetOriginId); v != tc.expected {
+				t.Fatalf("Expected TargetOriginId %q, got %q", tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestResourceAwsCloudFrontDistributionDiff_defaultTargetOriginId(t *testing.T) {
+	origin := func(id string) map[string]interface{} {
+		return map[string]interface{}{
+			"origin_id":   id,
+			"domain_name": id + ".example.com",
+			"custom_origin_config": []interface{}{
+				map[string]interface{}{
+					"http_port":              80,
+					"https_port":             443,
+					"origin_protocol_policy": "http-only",
+					"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+				},
+			},
 		}
-		viewer_protocol_policy = "allow-all"
-		min_ttl = 0
-		default_ttl = 3600
-		max_ttl = 86400
 	}
-	price_class = "PriceClass_200"
-	restrictions {
-		geo_restriction {
-			restriction_type = "whitelist"
-			locations = [ "US", "CA", "GB", "DE" ]
+	raw := func(targetOriginId string, origins ...interface{}) map[string]interface{} {
+		defaultCacheBehavior := map[string]interface{}{
+			"allowed_methods":        []interface{}{"GET", "HEAD"},
+			"cached_methods":         []interface{}{"GET", "HEAD"},
+			"viewer_protocol_policy": "allow-all",
+			"forwarded_values": []interface{}{
+				map[string]interface{}{
+					"query_string": false,
+					"cookies": []interface{}{
+						map[string]interface{}{"forward": "none"},
+					},
+				},
+			},
+		}
+		if targetOriginId != "" {
+			defaultCacheBehavior["target_origin_id"] = targetOriginId
+		}
+
+		return map[string]interface{}{
+			"enabled":                true,
+			"origin":                 origins,
+			"default_cache_behavior": []interface{}{defaultCacheBehavior},
+			"restrictions": []interface{}{
+				map[string]interface{}{
+					"geo_restriction": []interface{}{
+						map[string]interface{}{"restriction_type": "none"},
+					},
+				},
+			},
+			"viewer_certificate": []interface{}{
+				map[string]interface{}{"cloudfront_default_certificate": true},
+			},
 		}
 	}
-	viewer_certificate {
-		cloudfront_default_certificate = true
+
+	withoutDefaultCacheBehavior := raw("", origin("primary"))
+	delete(withoutDefaultCacheBehavior, "default_cache_behavior")
+
+	r := resourceAwsCloudFrontDistribution()
+
+	rc, err := config.NewRawConfig(withoutDefaultCacheBehavior)
+	if err != nil {
+		t.Fatalf("Error new raw config: %s", err)
 	}
-	tags = {
-            environment = "dev"
+	if _, errs := r.Validate(terraform.NewResourceConfig(rc)); len(errs) == 0 {
+		t.Fatalf("Expected an error for an omitted default_cache_behavior")
 	}
-	%s
-}
-`
 
-var testAccAWSCloudFrontDistributionCustomConfig = fmt.Sprintf(`
-variable rand_id {
-	default = %d
-}
+	// An omitted target_origin_id is planned as empty and inferred when the
+	// configuration is expanded. changed reports whether a target_origin_id
+	// change is planned, and expected is the planned value.
+	cases := []struct {
+		label    string
+		state    map[string]interface{}
+		raw      map[string]interface{}
+		changed  bool
+		expected string
+		err      string
+	}{
+		{"create explicit", nil, raw("primary", origin("primary")), true, "primary", ""},
+		{"create omitted", nil, raw("", origin("primary")), false, "", ""},
+		{"removed same origin", raw("primary", origin("primary")), raw("", origin("primary")), false, "", ""},
+		{"removed renamed origin", raw("primary", origin("primary")), raw("", origin("renamed")), true, "", ""},
+		{"create multiple origins omitted", nil, raw("", origin("primary"), origin("secondary")), false, "", "default_cache_behavior: target_origin_id must be set when the distribution has 2 origins"},
+	}
 
-# log bucket
-%s
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			var state *terraform.InstanceState
+			if tc.state != nil {
+				d := schema.TestResourceDataRaw(t, r.Schema, tc.state)
+				d.SetId("E74FTE3EXAMPLE")
+				state = d.State()
+			}
 
-resource "aws_cloudfront_distribution" "custom_distribution" {
-	origin {
-		domain_name = "www.example.com"
-		origin_id = "myCustomOrigin"
-		custom_origin_config {
-			http_port = 80
-			https_port = 443
-			origin_protocol_policy = "http-only"
-			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
-			origin_read_timeout = 30
-			origin_keepalive_timeout = 5
-		}
-	}
-	enabled = true
-	comment = "Some comment"
-	default_root_object = "index.html"
-	logging_config {
-		include_cookies = false
-		bucket = "${aws_s3_bucket.s3_bucket_logs.id}.s3.amazonaws.com"
-		prefix = "myprefix"
-	}
-	aliases = [ "mysite.${var.rand_id}.example.com", "*.yoursite.${var.rand_id}.example.com" ]
-	default_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myCustomOrigin"
-		smooth_streaming = false
-		forwarded_values {
-			query_string = false
-			cookies {
-				forward = "all"
+			rc, err := config.NewRawConfig(tc.raw)
+			if err != nil {
+				t.Fatalf("Error new raw config: %s", err)
 			}
+			diff, err := r.Diff(state, terraform.NewResourceConfig(rc), &AWSClient{})
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("Expected error %q, received: %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+
+			var attr *terraform.ResourceAttrDiff
+			if diff != nil {
+				attr = diff.Attributes["default_cache_behavior.0.target_origin_id"]
+			}
+			if !tc.changed {
+				if attr != nil && attr.Old != attr.New {
+					t.Fatalf("Expected no target_origin_id change, received: %#v", attr)
+				}
+				return
+			}
+			if attr == nil || attr.New != tc.expected {
+				t.Fatalf("Expected planned target_origin_id %q, received: %#v", tc.expected, attr)
+			}
+		})
+	}
+}
+
+func TestResourceAwsCloudFrontDistributionExpand_orderedCacheBehaviorOriginGroupTarget(t *testing.T) {
+	origin := func(id string) map[string]interface{} {
+		return map[string]interface{}{
+			"origin_id":   id,
+			"domain_name": id + ".example.com",
+			"custom_origin_config": []interface{}{
+				map[string]interface{}{
+					"http_port":              80,
+					"https_port":             443,
+					"origin_protocol_policy": "http-only",
+					"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+				},
+			},
 		}
-		viewer_protocol_policy = "allow-all"
-		min_ttl = 0
-		default_ttl = 3600
-		max_ttl = 86400
 	}
-	price_class = "PriceClass_200"
-	restrictions {
-		geo_restriction {
-			restriction_type = "whitelist"
-			locations = [ "US", "CA", "GB", "DE" ]
+	behavior := func(pathPattern, targetOriginId string) map[string]interface{} {
+		m := map[string]interface{}{
+			"allowed_methods":        []interface{}{"GET", "HEAD"},
+			"cached_methods":         []interface{}{"GET", "HEAD"},
+			"target_origin_id":       targetOriginId,
+			"viewer_protocol_policy": "allow-all",
+			"forwarded_values": []interface{}{
+				map[string]interface{}{
+					"query_string": false,
+					"cookies": []interface{}{
+						map[string]interface{}{"forward": "none"},
+					},
+				},
+			},
+		}
+		if pathPattern != "" {
+			m["path_pattern"] = pathPattern
 		}
+		return m
 	}
-	viewer_certificate {
-		cloudfront_default_certificate = true
+	raw := map[string]interface{}{
+		"enabled": true,
+		"origin":  []interface{}{origin("primary"), origin("failover")},
+		"origin_group": []interface{}{
+			map[string]interface{}{
+				"origin_id": "group",
+				"failover_criteria": []interface{}{
+					map[string]interface{}{"status_codes": []interface{}{500, 502}},
+				},
+				"member": []interface{}{
+					map[string]interface{}{"origin_id": "primary"},
+					map[string]interface{}{"origin_id": "failover"},
+				},
+			},
+		},
+		"default_cache_behavior": []interface{}{behavior("", "primary")},
+		"ordered_cache_behavior": []interface{}{
+			behavior("images/*", "group"),
+			behavior("videos/*", "group"),
+		},
+		"restrictions": []interface{}{
+			map[string]interface{}{
+				"geo_restriction": []interface{}{
+					map[string]interface{}{"restriction_type": "none"},
+				},
+			},
+		},
+		"viewer_certificate": []interface{}{
+			map[string]interface{}{"cloudfront_default_certificate": true},
+		},
 	}
-	%s
-}
-`, acctest.RandInt(), logBucket, testAccAWSCloudFrontDistributionRetainConfig())
-
-var testAccAWSCloudFrontDistributionMultiOriginConfig = fmt.Sprintf(`
-variable rand_id {
-	default = %d
-}
 
-# origin bucket
-%s
+	d := schema.TestResourceDataRaw(t, resourceAwsCloudFrontDistribution().Schema, raw)
 
-# log bucket
-%s
+	if err := validateCloudFrontDistributionTargetOriginIds(d); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
 
-resource "aws_cloudfront_distribution" "multi_origin_distribution" {
-	origin {
-		domain_name = "${aws_s3_bucket.s3_bucket_origin.id}.s3.amazonaws.com"
-		origin_id = "myS3Origin"
+	distributionConfig := expandDistributionConfig(d)
+	if n := len(distributionConfig.CacheBehaviors.Items); n != 2 {
+		t.Fatalf("Expected 2 cache behaviors, got %d", n)
 	}
-	origin {
-		domain_name = "www.example.com"
-		origin_id = "myCustomOrigin"
-		custom_origin_config {
-			http_port = 80
-			https_port = 443
-			origin_protocol_policy = "http-only"
-			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
-			origin_keepalive_timeout = 45
+	for _, cacheBehavior := range distributionConfig.CacheBehaviors.Items {
+		if v := aws.StringValue(cacheBehavior.TargetOriginId); v != "group" {
+			t.Fatalf("Expected cache behavior %q TargetOriginId %q, got %q", aws.StringValue(cacheBehavior.PathPattern), "group", v)
 		}
 	}
-	enabled = true
-	comment = "Some comment"
-	default_root_object = "index.html"
-	logging_config {
-		include_cookies = false
-		bucket = "${aws_s3_bucket.s3_bucket_logs.id}.s3.amazonaws.com"
-		prefix = "myprefix"
+}
+
+func TestCloudFrontDistributionViewerCertificateError(t *testing.T) {
+	err := cloudFrontDistributionViewerCertificateError(awserr.New(cloudfront.ErrCodeInvalidViewerCertificate, "The specified SSL certificate doesn't exist, isn't in us-east-1 region, isn't valid, or doesn't include a valid certificate chain.", nil))
+	if err == nil {
+		t.Fatal("Expected error")
 	}
-	aliases = [ "mysite.${var.rand_id}.example.com", "*.yoursite.${var.rand_id}.example.com" ]
-	default_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myS3Origin"
-		smooth_streaming = true
-		forwarded_values {
-			query_string = false
-			cookies {
-				forward = "all"
-			}
+	for _, s := range []string{"us-east-1", "has been issued", "/cloudfront/", "covers every alias", cloudfront.ErrCodeInvalidViewerCertificate} {
+		if !strings.Contains(err.Error(), s) {
+			t.Fatalf("Expected error to contain %q, got: %s", s, err)
 		}
-		min_ttl = 100
-		default_ttl = 100
-		max_ttl = 100
-		viewer_protocol_policy = "allow-all"
 	}
-	ordered_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myS3Origin"
-		forwarded_values {
-			query_string = true
-			cookies {
-				forward = "none"
-			}
-		}
-		min_ttl = 50
-		default_ttl = 50
-		max_ttl = 50
-		viewer_protocol_policy = "allow-all"
-		path_pattern = "images1/*.jpg"
+
+	other := awserr.New(cloudfront.ErrCodeInvalidArgument, "other", nil)
+	if err := cloudFrontDistributionViewerCertificateError(other); err != other {
+		t.Fatalf("Expected other errors to be returned unchanged, got: %s", err)
 	}
-	ordered_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myCustomOrigin"
-		forwarded_values {
-			query_string = true
-			cookies {
-				forward = "none"
-			}
-		}
-		min_ttl = 50
-		default_ttl = 50
-		max_ttl = 50
-		viewer_protocol_policy = "allow-all"
-		path_pattern = "images2/*.jpg"
+	if err := cloudFrontDistributionViewerCertificateError(nil); err != nil {
+		t.Fatalf("Expected no error, got: %s", err)
 	}
+}
 
-	price_class = "PriceClass_All"
-	custom_error_response {
-		error_code = 404
-		response_page_path = "/error-pages/404.html"
-		response_code = 200
-		error_caching_min_ttl = 30
+func TestResourceAwsCloudFrontDistributionDisable(t *testing.T) {
+	cases := []struct {
+		label          string
+		enabled        bool
+		expectedUpdate bool
+	}{
+		{"enabled", true, true},
+		{"already disabled", false, false},
 	}
-	restrictions {
-		geo_restriction {
-			restriction_type = "none"
-		}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
 	}
-	viewer_certificate {
-		cloudfront_default_certificate = true
+
+	conn := cloudfront.New(sess)
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			var updates []*cloudfront.UpdateDistributionInput
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch params := r.Params.(type) {
+				case *cloudfront.GetDistributionConfigInput:
+					output := r.Data.(*cloudfront.GetDistributionConfigOutput)
+					output.DistributionConfig = &cloudfront.DistributionConfig{
+						Comment: aws.String("unchanged"),
+						Enabled: aws.Bool(tc.enabled),
+					}
+					output.ETag = aws.String("E2LIVE")
+				case *cloudfront.UpdateDistributionInput:
+					updates = append(updates, params)
+				}
+			})
+
+			if err := resourceAwsCloudFrontDistributionDisable(conn, "E74FTE3EXAMPLE"); err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+
+			if !tc.expectedUpdate {
+				if len(updates) != 0 {
+					t.Fatalf("Expected no update, received: %d", len(updates))
+				}
+				return
+			}
+
+			if len(updates) != 1 {
+				t.Fatalf("Expected 1 update, received: %d", len(updates))
+			}
+			update := updates[0]
+			if aws.BoolValue(update.DistributionConfig.Enabled) {
+				t.Fatalf("Expected distribution to be disabled")
+			}
+			if v := aws.StringValue(update.DistributionConfig.Comment); v != "unchanged" {
+				t.Fatalf("Expected live configuration to be preserved, received comment: %q", v)
+			}
+			if v := aws.StringValue(update.IfMatch); v != "E2LIVE" {
+				t.Fatalf("Expected IfMatch %q, received: %q", "E2LIVE", v)
+			}
+		})
 	}
-	%s
 }
-`, acctest.RandInt(), originBucket, logBucket, testAccAWSCloudFrontDistributionRetainConfig())
 
-var testAccAWSCloudFrontDistributionNoCustomErroResponseInfo = fmt.Sprintf(`
-variable rand_id {
-	default = %d
-}
+func TestResourceAwsCloudFrontDistributionDelete_disable(t *testing.T) {
+	raw := map[string]interface{}{
+		"enabled": true,
+		"origin": []interface{}{
+			map[string]interface{}{
+				"origin_id":   "myCustomOrigin",
+				"domain_name": "www.example.com",
+				"custom_origin_config": []interface{}{
+					map[string]interface{}{
+						"http_port":              80,
+						"https_port":             443,
+						"origin_protocol_policy": "http-only",
+						"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+					},
+				},
+			},
+		},
+		"default_cache_behavior": []interface{}{
+			map[string]interface{}{
+				"allowed_methods":        []interface{}{"GET", "HEAD"},
+				"cached_methods":         []interface{}{"GET", "HEAD"},
+				"target_origin_id":       "myCustomOrigin",
+				"viewer_protocol_policy": "allow-all",
+				"forwarded_values": []interface{}{
+					map[string]interface{}{
+						"query_string": false,
+						"cookies": []interface{}{
+							map[string]interface{}{"forward": "none"},
+						},
+					},
+				},
+			},
+		},
+		"restrictions": []interface{}{
+			map[string]interface{}{
+				"geo_restriction": []interface{}{
+					map[string]interface{}{"restriction_type": "none"},
+				},
+			},
+		},
+		"viewer_certificate": []interface{}{
+			map[string]interface{}{"cloudfront_default_certificate": true},
+		},
+		// Skip the deployment waiter, which polls no sooner than 10 minutes
+		"skip_disable_wait": true,
+	}
 
-resource "aws_cloudfront_distribution" "no_custom_error_responses" {
-	origin {
-		domain_name = "www.example.com"
-		origin_id = "myCustomOrigin"
-		custom_origin_config {
-			http_port = 80
-			https_port = 443
-			origin_protocol_policy = "http-only"
-			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
-		}
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
 	}
-	enabled = true
-	comment = "Some comment"
-	default_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myCustomOrigin"
-		smooth_streaming = false
-		forwarded_values {
-			query_string = false
-			cookies {
-				forward = "all"
+
+	// Delete is called with the state only, so nothing has changed
+	r := resourceAwsCloudFrontDistribution()
+	state := schema.TestResourceDataRaw(t, r.Schema, raw)
+	state.SetId("E74FTE3EXAMPLE")
+	state.Set("etag", "E2ENABLED")
+	d := r.Data(state.State())
+	enabledConfig := expandDistributionConfig(d)
+
+	var updates []*cloudfront.UpdateDistributionInput
+	var deletes []*cloudfront.DeleteDistributionInput
+	conn := cloudfront.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch params := r.Params.(type) {
+		case *cloudfront.UpdateDistributionInput:
+			updates = append(updates, params)
+			r.Data.(*cloudfront.UpdateDistributionOutput).ETag = aws.String("E2DISABLED")
+		case *cloudfront.GetDistributionInput:
+			distributionConfig, etag := enabledConfig, "E2ENABLED"
+			if len(updates) > 0 {
+				distributionConfig, etag = updates[len(updates)-1].DistributionConfig, "E2DISABLED"
 			}
+			r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+				ARN:                aws.String("arn:aws:cloudfront::123456789012:distribution/" + aws.StringValue(params.Id)),
+				Id:                 params.Id,
+				LastModifiedTime:   aws.Time(time.Now()),
+				Status:             aws.String("Deployed"),
+				DistributionConfig: distributionConfig,
+				ActiveTrustedSigners: &cloudfront.ActiveTrustedSigners{
+					Enabled: aws.Bool(false),
+				},
+			}
+			r.Data.(*cloudfront.GetDistributionOutput).ETag = aws.String(etag)
+		case *cloudfront.ListTagsForResourceInput:
+			r.Data.(*cloudfront.ListTagsForResourceOutput).Tags = &cloudfront.Tags{}
+		case *cloudfront.DeleteDistributionInput:
+			deletes = append(deletes, params)
 		}
-		viewer_protocol_policy = "allow-all"
-		min_ttl = 0
-		default_ttl = 3600
-		max_ttl = 86400
+	})
+
+	meta := &AWSClient{cloudfrontconn: conn}
+	if err := resourceAwsCloudFrontDistributionDelete(d, meta); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
 	}
-	custom_error_response {
-		error_code = 404
-		error_caching_min_ttl = 30
+
+	if len(updates) != 1 {
+		t.Fatalf("Expected the distribution to be disabled, received %d updates", len(updates))
 	}
-	restrictions {
-		geo_restriction {
-			restriction_type = "whitelist"
-			locations = [ "US", "CA", "GB", "DE" ]
-		}
+	if aws.BoolValue(updates[0].DistributionConfig.Enabled) {
+		t.Fatalf("Expected the update to disable the distribution")
 	}
-	viewer_certificate {
-		cloudfront_default_certificate = true
+	if v := aws.StringValue(updates[0].IfMatch); v != "E2ENABLED" {
+		t.Fatalf("Expected update IfMatch %q, received: %q", "E2ENABLED", v)
+	}
+	if len(deletes) != 1 {
+		t.Fatalf("Expected the distribution to be deleted, received %d deletes", len(deletes))
+	}
+	if v := aws.StringValue(deletes[0].IfMatch); v != "E2DISABLED" {
+		t.Fatalf("Expected delete IfMatch %q, received: %q", "E2DISABLED", v)
 	}
-	%s
-}
-`, acctest.RandInt(), testAccAWSCloudFrontDistributionRetainConfig())
-
-var testAccAWSCloudFrontDistributionNoOptionalItemsConfig = fmt.Sprintf(`
-variable rand_id {
-	default = %d
 }
 
-resource "aws_cloudfront_distribution" "no_optional_items" {
-	origin {
-		domain_name = "www.example.com"
-		origin_id = "myCustomOrigin"
-		custom_origin_config {
-			http_port = 80
-			https_port = 443
-			origin_protocol_policy = "http-only"
-			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
+func TestResourceAwsCloudFrontDistributionDelete_timeout(t *testing.T) {
+	raw := func(deleteAfter string) map[string]interface{} {
+		m := map[string]interface{}{
+			"enabled": true,
+			"origin": []interface{}{
+				map[string]interface{}{
+					"origin_id":   "myCustomOrigin",
+					"domain_name": "www.example.com",
+					"custom_origin_config": []interface{}{
+						map[string]interface{}{
+							"http_port":              80,
+							"https_port":             443,
+							"origin_protocol_policy": "http-only",
+							"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+						},
+					},
+				},
+			},
+			"default_cache_behavior": []interface{}{
+				map[string]interface{}{
+					"allowed_methods":        []interface{}{"GET", "HEAD"},
+					"cached_methods":         []interface{}{"GET", "HEAD"},
+					"target_origin_id":       "myCustomOrigin",
+					"viewer_protocol_policy": "allow-all",
+					"forwarded_values": []interface{}{
+						map[string]interface{}{
+							"query_string": false,
+							"cookies": []interface{}{
+								map[string]interface{}{"forward": "none"},
+							},
+						},
+					},
+				},
+			},
+			"restrictions": []interface{}{
+				map[string]interface{}{
+					"geo_restriction": []interface{}{
+						map[string]interface{}{"restriction_type": "none"},
+					},
+				},
+			},
+			"viewer_certificate": []interface{}{
+				map[string]interface{}{"cloudfront_default_certificate": true},
+			},
+			// Skip the deployment waiter, which polls no sooner than 10 minutes
+			"skip_disable_wait": true,
 		}
+		if deleteAfter != "" {
+			m["delete_after"] = deleteAfter
+		}
+		return m
 	}
-	enabled = true
-	default_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myCustomOrigin"
-		smooth_streaming = false
-		forwarded_values {
-			query_string = false
-			cookies {
-				forward = "all"
+
+	// Disabling the distribution takes updateDelay of the delete timeout, so
+	// only the rest of it is left for delete_after and retrying the delete.
+	cases := []struct {
+		label       string
+		deleteAfter string
+		notDisabled bool
+		deletes     bool
+		err         string
+	}{
+		{"deleted", "", false, true, ""},
+		{"delete_after within the remaining time", "100ms", false, true, ""},
+		{"delete_after beyond the remaining time", "1s", false, false, ""},
+		{"not disabled before the remaining time ends", "", true, true, "has not finished propagating"},
+	}
+
+	timeout := 2 * time.Second
+	updateDelay := 1500 * time.Millisecond
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			r := resourceAwsCloudFrontDistribution()
+			state := schema.TestResourceDataRaw(t, r.Schema, raw(tc.deleteAfter))
+			state.SetId("E74FTE3EXAMPLE")
+			state.Set("etag", "E2ENABLED")
+			r.Timeouts = &schema.ResourceTimeout{Delete: schema.DefaultTimeout(timeout)}
+			d := r.Data(state.State())
+			distributionConfig := expandDistributionConfig(d)
+
+			var deletes int
+			conn := cloudfront.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch params := r.Params.(type) {
+				case *cloudfront.UpdateDistributionInput:
+					time.Sleep(updateDelay)
+					distributionConfig = params.DistributionConfig
+					r.Data.(*cloudfront.UpdateDistributionOutput).ETag = aws.String("E2DISABLED")
+				case *cloudfront.GetDistributionInput:
+					r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+						ARN:                aws.String("arn:aws:cloudfront::123456789012:distribution/" + aws.StringValue(params.Id)),
+						Id:                 params.Id,
+						LastModifiedTime:   aws.Time(time.Now()),
+						Status:             aws.String("InProgress"),
+						DistributionConfig: distributionConfig,
+						ActiveTrustedSigners: &cloudfront.ActiveTrustedSigners{
+							Enabled: aws.Bool(false),
+						},
+					}
+					r.Data.(*cloudfront.GetDistributionOutput).ETag = aws.String("E2DISABLED")
+				case *cloudfront.ListTagsForResourceInput:
+					r.Data.(*cloudfront.ListTagsForResourceOutput).Tags = &cloudfront.Tags{}
+				case *cloudfront.DeleteDistributionInput:
+					deletes++
+					if tc.notDisabled {
+						r.Error = awserr.New(cloudfront.ErrCodeDistributionNotDisabled, "The distribution you are trying to delete has not been disabled.", nil)
+					}
+				}
+			})
+
+			start := time.Now()
+			err := resourceAwsCloudFrontDistributionDelete(d, &AWSClient{cloudfrontconn: conn})
+			elapsed := time.Since(start)
+
+			if tc.err == "" && err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("Expected error containing %q, received: %v", tc.err, err)
+			}
+			if tc.deletes != (deletes > 0) {
+				t.Fatalf("Expected delete attempted %t, received %d deletes", tc.deletes, deletes)
 			}
+			if max := timeout + 500*time.Millisecond; elapsed > max {
+				t.Fatalf("Expected delete to finish within the %s timeout, took %s", timeout, elapsed)
+			}
+		})
+	}
+}
+
+func TestResourceAwsCloudFrontDistributionUpdate_changes(t *testing.T) {
+	raw := func(changes map[string]interface{}) map[string]interface{} {
+		m := map[string]interface{}{
+			"enabled": true,
+			"origin": []interface{}{
+				map[string]interface{}{
+					"origin_id":   "myCustomOrigin",
+					"domain_name": "www.example.com",
+					"custom_origin_config": []interface{}{
+						map[string]interface{}{
+							"http_port":              80,
+							"https_port":             443,
+							"origin_protocol_policy": "http-only",
+							"origin_ssl_protocols":   []interface{}{"TLSv1.2"},
+						},
+					},
+				},
+			},
+			"default_cache_behavior": []interface{}{
+				map[string]interface{}{
+					"allowed_methods":        []interface{}{"GET", "HEAD"},
+					"cached_methods":         []interface{}{"GET", "HEAD"},
+					"target_origin_id":       "myCustomOrigin",
+					"viewer_protocol_policy": "allow-all",
+					"forwarded_values": []interface{}{
+						map[string]interface{}{
+							"query_string": false,
+							"cookies": []interface{}{
+								map[string]interface{}{"forward": "none"},
+							},
+						},
+					},
+				},
+			},
+			"restrictions": []interface{}{
+				map[string]interface{}{
+					"geo_restriction": []interface{}{
+						map[string]interface{}{"restriction_type": "none"},
+					},
+				},
+			},
+			"viewer_certificate": []interface{}{
+				map[string]interface{}{"cloudfront_default_certificate": true},
+			},
 		}
-		viewer_protocol_policy = "allow-all"
+		for k, v := range changes {
+			m[k] = v
+		}
+		return m
 	}
-	restrictions {
-		geo_restriction {
-			restriction_type = "whitelist"
-			locations = [ "US", "CA", "GB", "DE" ]
+
+	cases := []struct {
+		label          string
+		changes        map[string]interface{}
+		expectedUpdate bool
+	}{
+		{"no change", nil, false},
+		{"tags", map[string]interface{}{"tags": map[string]interface{}{"Name": "example"}}, false},
+		{"force_destroy", map[string]interface{}{"force_destroy": true}, false},
+		{"skip_disable_wait", map[string]interface{}{"skip_disable_wait": true}, false},
+		{"retain_on_delete", map[string]interface{}{"retain_on_delete": true}, false},
+		{"delete_after", map[string]interface{}{"delete_after": "30m"}, false},
+		{"ignore_tagging_access_denied", map[string]interface{}{"ignore_tagging_access_denied": true}, false},
+		{"comment", map[string]interface{}{"comment": "changed"}, true},
+		{"enabled", map[string]interface{}{"enabled": false}, true},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			r := resourceAwsCloudFrontDistribution()
+			d := schema.TestResourceDataRaw(t, r.Schema, raw(nil))
+			d.SetId("E74FTE3EXAMPLE")
+			d.Set("etag", "E2QWRUHEXAMPLE")
+			state := d.State()
+
+			var updates int
+			conn := cloudfront.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch params := r.Params.(type) {
+				case *cloudfront.UpdateDistributionInput:
+					updates++
+				case *cloudfront.GetDistributionInput:
+					r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+						ARN:                aws.String("arn:aws:cloudfront::123456789012:distribution/" + aws.StringValue(params.Id)),
+						Id:                 params.Id,
+						LastModifiedTime:   aws.Time(time.Now()),
+						DistributionConfig: expandDistributionConfig(d),
+						ActiveTrustedSigners: &cloudfront.ActiveTrustedSigners{
+							Enabled: aws.Bool(false),
+						},
+					}
+				case *cloudfront.ListTagsForResourceInput:
+					r.Data.(*cloudfront.ListTagsForResourceOutput).Tags = &cloudfront.Tags{}
+				}
+			})
+			meta := &AWSClient{cloudfrontconn: conn}
+
+			rc, err := config.NewRawConfig(raw(tc.changes))
+			if err != nil {
+				t.Fatalf("Error new raw config: %s", err)
+			}
+			diff, err := r.Diff(state, terraform.NewResourceConfig(rc), meta)
+			if err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if _, err := r.Apply(state, diff, meta); err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+
+			if tc.expectedUpdate && updates != 1 {
+				t.Fatalf("Expected the distribution to be updated, received %d updates", updates)
+			}
+			if !tc.expectedUpdate && updates != 0 {
+				t.Fatalf("Expected the distribution not to be updated, received %d updates", updates)
+			}
+		})
+	}
+}
+
+func TestResourceAwsCloudFrontDistributionDelete_deleteAfterTimeout(t *testing.T) {
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	var updates, deletes int
+	conn := cloudfront.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch params := r.Params.(type) {
+		case *cloudfront.GetDistributionConfigInput:
+			output := r.Data.(*cloudfront.GetDistributionConfigOutput)
+			output.DistributionConfig = &cloudfront.DistributionConfig{
+				Enabled: aws.Bool(true),
+			}
+			output.ETag = aws.String("E2LIVE")
+		case *cloudfront.UpdateDistributionInput:
+			updates++
+		case *cloudfront.GetDistributionInput:
+			r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+				ARN:              aws.String("arn:aws:cloudfront::123456789012:distribution/" + aws.StringValue(params.Id)),
+				Id:               params.Id,
+				LastModifiedTime: aws.Time(time.Now()),
+				DistributionConfig: &cloudfront.DistributionConfig{
+					DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+					Enabled:              aws.Bool(false),
+					Origins:              expandOrigins(multiOriginConf()),
+					ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+				},
+				ActiveTrustedSigners: &cloudfront.ActiveTrustedSigners{
+					Enabled: aws.Bool(false),
+				},
+			}
+		case *cloudfront.ListTagsForResourceInput:
+			r.Data.(*cloudfront.ListTagsForResourceOutput).Tags = &cloudfront.Tags{}
+		case *cloudfront.DeleteDistributionInput:
+			deletes++
 		}
+	})
+
+	meta := &AWSClient{cloudfrontconn: conn}
+	d := resourceAwsCloudFrontDistribution().Data(nil)
+	d.SetId("E74FTE3EXAMPLE")
+	d.Set("force_destroy", true)
+	// Longer than the default delete timeout
+	d.Set("delete_after", "2h")
+
+	if err := resourceAwsCloudFrontDistributionDelete(d, meta); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
 	}
-	viewer_certificate {
-		cloudfront_default_certificate = true
+	if updates != 1 {
+		t.Fatalf("Expected the distribution to be disabled, received %d updates", updates)
 	}
-	%s
+	if deletes != 0 {
+		t.Fatalf("Expected the distribution not to be deleted, received %d deletes", deletes)
+	}
+}
+
+func TestResourceAwsCloudFrontDistributionDelete_skipDisableWait(t *testing.T) {
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	var updates, deletes int
+	conn := cloudfront.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch params := r.Params.(type) {
+		case *cloudfront.GetDistributionConfigInput:
+			output := r.Data.(*cloudfront.GetDistributionConfigOutput)
+			output.DistributionConfig = &cloudfront.DistributionConfig{
+				Enabled: aws.Bool(true),
+			}
+			output.ETag = aws.String("E2LIVE")
+		case *cloudfront.UpdateDistributionInput:
+			updates++
+		case *cloudfront.GetDistributionInput:
+			r.Data.(*cloudfront.GetDistributionOutput).Distribution = &cloudfront.Distribution{
+				ARN:    aws.String("arn:aws:cloudfront::123456789012:distribution/" + aws.StringValue(params.Id)),
+				Id:     params.Id,
+				Status: aws.String("InProgress"),
+				DistributionConfig: &cloudfront.DistributionConfig{
+					DefaultCacheBehavior: expandCloudFrontDefaultCacheBehavior(defaultCacheBehaviorConf()),
+					Enabled:              aws.Bool(false),
+					Origins:              expandOrigins(multiOriginConf()),
+					ViewerCertificate:    expandViewerCertificate(viewerCertificateConfSetCloudFrontDefault()),
+				},
+				ActiveTrustedSigners: &cloudfront.ActiveTrustedSigners{
+					Enabled: aws.Bool(false),
+				},
+			}
+		case *cloudfront.ListTagsForResourceInput:
+			r.Data.(*cloudfront.ListTagsForResourceOutput).Tags = &cloudfront.Tags{}
+		case *cloudfront.DeleteDistributionInput:
+			deletes++
+			// The disable has not finished deploying on the first attempt
+			if deletes == 1 {
+				r.Error = awserr.New(cloudfront.ErrCodeDistributionNotDisabled, "The distribution you are trying to delete has not been disabled.", nil)
+			}
+		}
+	})
+
+	meta := &AWSClient{cloudfrontconn: conn}
+	d := resourceAwsCloudFrontDistribution().Data(nil)
+	d.SetId("E74FTE3EXAMPLE")
+	d.Set("force_destroy", true)
+	d.Set("skip_disable_wait", true)
+
+	if err := resourceAwsCloudFrontDistributionDelete(d, meta); err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+	if updates != 1 {
+		t.Fatalf("Expected the distribution to be disabled, received %d updates", updates)
+	}
+	if deletes != 2 {
+		t.Fatalf("Expected the delete to be retried until it succeeds, received %d deletes", deletes)
+	}
+}
+
+func TestResourceAwsCloudFrontDistributionWaitForDeleteAfter(t *testing.T) {
+	cases := []struct {
+		label       string
+		disabledAt  time.Time
+		deleteAfter time.Duration
+		minWait     time.Duration
+	}{
+		{"no window", time.Now(), 0, 0},
+		{"window passed", time.Now().Add(-time.Minute), 30 * time.Second, 0},
+		{"window remaining", time.Now(), 100 * time.Millisecond, 50 * time.Millisecond},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			start := time.Now()
+			resourceAwsCloudFrontDistributionWaitForDeleteAfter("E74FTE3EXAMPLE", tc.disabledAt, tc.deleteAfter)
+			elapsed := time.Since(start)
+
+			if elapsed < tc.minWait {
+				t.Fatalf("Expected to wait at least %s, waited %s", tc.minWait, elapsed)
+			}
+			if elapsed > tc.deleteAfter+time.Second {
+				t.Fatalf("Expected to wait at most %s, waited %s", tc.deleteAfter, elapsed)
+			}
+		})
+	}
+}
+
+func TestResourceAwsCloudFrontDistributionUpdateDistribution_staleETag(t *testing.T) {
+	cases := []struct {
+		label string
+		code  string
+	}{
+		{"precondition failed", cloudfront.ErrCodePreconditionFailed},
+		{"invalid if match version", cloudfront.ErrCodeInvalidIfMatchVersion},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	conn := cloudfront.New(sess)
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			var ifMatches []string
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch params := r.Params.(type) {
+				case *cloudfront.GetDistributionInput:
+					r.Data.(*cloudfront.GetDistributionOutput).ETag = aws.String("E2REFRESHED")
+				case *cloudfront.UpdateDistributionInput:
+					ifMatches = append(ifMatches, aws.StringValue(params.IfMatch))
+					if aws.StringValue(params.IfMatch) != "E2REFRESHED" {
+						r.Error = awserr.New(tc.code, "stale ETag", nil)
+					}
+				}
+			})
+
+			input := &cloudfront.UpdateDistributionInput{
+				Id:      aws.String("E74FTE3EXAMPLE"),
+				IfMatch: aws.String("E1STALE"),
+			}
+			if err := resourceAwsCloudFrontDistributionUpdateDistribution(conn, input); err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+
+			expected := []string{"E1STALE", "E2REFRESHED"}
+			if !reflect.DeepEqual(ifMatches, expected) {
+				t.Fatalf("Received IfMatch values: %q\nExpected: %q\n", ifMatches, expected)
+			}
+		})
+	}
+}
+
+func TestValidateCloudFrontOriginCustomHeaders(t *testing.T) {
+	cases := []struct {
+		label   string
+		headers []interface{}
+		err     string
+	}{
+		{
+			"unique names",
+			[]interface{}{originCustomHeaderConf1(), originCustomHeaderConf2()},
+			"",
+		},
+		{
+			"duplicate name with different values",
+			[]interface{}{
+				map[string]interface{}{"name": "X-Custom-Header", "value": "value1"},
+				map[string]interface{}{"name": "X-Custom-Header", "value": "value2"},
+			},
+			`duplicate custom_header name "X-Custom-Header"`,
+		},
+		{
+			"duplicate name with different case",
+			[]interface{}{
+				map[string]interface{}{"name": "X-Custom-Header", "value": "value"},
+				map[string]interface{}{"name": "x-custom-header", "value": "value"},
+			},
+			"duplicate custom_header name",
+		},
+		{
+			"no headers",
+			[]interface{}{},
+			"",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			err := validateCloudFrontOriginCustomHeaders(schema.NewSet(originCustomHeaderHash, tc.headers))
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, received: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("Expected error containing %q, received: %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestValidateCloudFrontTargetOriginId(t *testing.T) {
+	originIds := cloudFrontDistributionOriginIds(multiOriginConf(), originGroupsConf())
+
+	cases := []struct {
+		targetOriginId string
+		valid          bool
+	}{
+		{"CustomOrigin", true},
+		{"S3Origin", true},
+		{"OriginGroup", true},
+		{"", true},
+		{"MissingOrigin", false},
+	}
+
+	for _, tc := range cases {
+		err := validateCloudFrontTargetOriginId(tc.targetOriginId, originIds)
+		if tc.valid && err != nil {
+			t.Fatalf("%q: expected no error, received: %s", tc.targetOriginId, err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("%q: expected error", tc.targetOriginId)
+		}
+	}
+}
+
+func TestValidateCloudFrontForwardedValuesCookies(t *testing.T) {
+	cases := []struct {
+		forward          string
+		whitelistedNames []interface{}
+		valid            bool
+	}{
+		{"whitelist", []interface{}{"Example1", "Example2"}, true},
+		{"all", []interface{}{}, true},
+		{"none", []interface{}{}, true},
+		{"", []interface{}{"Example1"}, true},
+		{"all", []interface{}{"Example1"}, false},
+		{"none", []interface{}{"Example1"}, false},
+	}
+
+	for _, tc := range cases {
+		forwardedValues := []interface{}{
+			map[string]interface{}{
+				"cookies": []interface{}{
+					map[string]interface{}{
+						"forward":           tc.forward,
+						"whitelisted_names": schema.NewSet(schema.HashString, tc.whitelistedNames),
+					},
+				},
+			},
+		}
+
+		err := validateCloudFrontForwardedValuesCookies(forwardedValues)
+		if tc.valid && err != nil {
+			t.Fatalf("%q %v: expected no error, received: %s", tc.forward, tc.whitelistedNames, err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("%q %v: expected error", tc.forward, tc.whitelistedNames)
+		}
+	}
+}
+
+func TestCloudFrontCacheBehaviorForwardsHostToS3Origin(t *testing.T) {
+	s3OriginIds := cloudFrontDistributionS3OriginIds(multiOriginConf())
+
+	cases := []struct {
+		label          string
+		targetOriginId string
+		headers        []interface{}
+		expected       bool
+	}{
+		{"Host to S3 origin", "S3Origin", []interface{}{"Host"}, true},
+		{"lowercase host to S3 origin", "S3Origin", []interface{}{"Origin", "host"}, true},
+		{"Host to custom origin", "CustomOrigin", []interface{}{"Host"}, false},
+		{"other headers to S3 origin", "S3Origin", []interface{}{"Origin"}, false},
+		{"all headers to S3 origin", "S3Origin", []interface{}{"*"}, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			behavior := map[string]interface{}{
+				"target_origin_id": tc.targetOriginId,
+				"forwarded_values": []interface{}{
+					map[string]interface{}{
+						"headers": schema.NewSet(schema.HashString, tc.headers),
+					},
+				},
+			}
+
+			if got := cloudFrontCacheBehaviorForwardsHostToS3Origin(behavior, s3OriginIds); got != tc.expected {
+				t.Fatalf("Expected %t, received: %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCloudFrontOriginIsHttpOnlyToHttpsEndpoint(t *testing.T) {
+	origin := func(domainName, originProtocolPolicy string) map[string]interface{} {
+		return map[string]interface{}{
+			"origin_id":   "myOrigin",
+			"domain_name": domainName,
+			"custom_origin_config": []interface{}{
+				map[string]interface{}{
+					"origin_protocol_policy": originProtocolPolicy,
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		label    string
+		origin   map[string]interface{}
+		expected bool
+	}{
+		{"ALB http-only", origin("my-alb-1234567890.us-west-2.elb.amazonaws.com", "http-only"), true},
+		{"internal ELB http-only", origin("internal-my-elb-1234567890.us-west-2.elb.amazonaws.com", "http-only"), true},
+		{"API Gateway http-only", origin("a1b2c3d4e5.execute-api.us-east-1.amazonaws.com", "http-only"), true},
+		{"ALB https-only", origin("my-alb-1234567890.us-west-2.elb.amazonaws.com", "https-only"), false},
+		{"API Gateway match-viewer", origin("a1b2c3d4e5.execute-api.us-east-1.amazonaws.com", "match-viewer"), false},
+		{"other domain http-only", origin("www.example.com", "http-only"), false},
+		{"S3 origin", map[string]interface{}{
+			"origin_id":   "myOrigin",
+			"domain_name": "mybucket.s3.amazonaws.com",
+		}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			if got := cloudFrontOriginIsHttpOnlyToHttpsEndpoint(tc.origin); got != tc.expected {
+				t.Fatalf("Expected %t, received: %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResourceAwsCloudFrontDistributionOriginSslProtocols_validate(t *testing.T) {
+	customOriginConfig := resourceAwsCloudFrontDistribution().Schema["origin"].Elem.(*schema.Resource).Schema["custom_origin_config"].Elem.(*schema.Resource)
+	validateFunc := customOriginConfig.Schema["origin_ssl_protocols"].Elem.(*schema.Schema).ValidateFunc
+
+	// Deprecated protocols only cause a warning
+	for v, warnCount := range map[string]int{"SSLv3": 1, "TLSv1": 1, "TLSv1.1": 1, "TLSv1.2": 0} {
+		warnings, errors := validateFunc(v, "origin_ssl_protocols")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid origin_ssl_protocols value: %q", v, errors)
+		}
+		if len(warnings) != warnCount {
+			t.Fatalf("Expected %d warnings for %q, received: %q", warnCount, v, warnings)
+		}
+	}
+
+	for _, v := range []string{"", "TLSv1.3", "tlsv1.2", "SSLv2"} {
+		if _, errors := validateFunc(v, "origin_ssl_protocols"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid origin_ssl_protocols value", v)
+		}
+	}
+}
+
+func TestCloudFrontPriceClassAllBlacklistedLocations(t *testing.T) {
+	restrictions := func(restrictionType string, locations ...interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"geo_restriction": []interface{}{
+					map[string]interface{}{
+						"restriction_type": restrictionType,
+						"locations":        schema.NewSet(schema.HashString, locations),
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		label        string
+		priceClass   string
+		restrictions []interface{}
+		expected     int
+	}{
+		{"all with blacklist", "PriceClass_All", restrictions("blacklist", "CN", "RU"), 2},
+		{"all with whitelist", "PriceClass_All", restrictions("whitelist", "US", "CA"), 0},
+		{"all without restriction", "PriceClass_All", restrictions("none"), 0},
+		{"100 with blacklist", "PriceClass_100", restrictions("blacklist", "CN", "RU"), 0},
+		{"no restrictions", "PriceClass_All", []interface{}{}, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			if got := cloudFrontPriceClassAllBlacklistedLocations(tc.priceClass, tc.restrictions); len(got) != tc.expected {
+				t.Fatalf("Expected %d locations, received: %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCloudFrontDistributionSummaryJson(t *testing.T) {
+	cases := []struct {
+		label        string
+		distribution *cloudfront.Distribution
+		expected     string
+	}{
+		{
+			label: "aliases",
+			distribution: &cloudfront.Distribution{
+				ARN:        aws.String("arn:aws:cloudfront::123456789012:distribution/E74FTE3EXAMPLE"),
+				DomainName: aws.String("d111111abcdef8.cloudfront.net"),
+				Status:     aws.String("Deployed"),
+				DistributionConfig: &cloudfront.DistributionConfig{
+					Aliases: &cloudfront.Aliases{
+						Items:    aws.StringSlice([]string{"www.example.com", "example.com"}),
+						Quantity: aws.Int64(2),
+					},
+				},
+			},
+			expected: `{"aliases":["example.com","www.example.com"],"arn":"arn:aws:cloudfront::123456789012:distribution/E74FTE3EXAMPLE","domain_name":"d111111abcdef8.cloudfront.net","status":"Deployed"}`,
+		},
+		{
+			label: "no aliases",
+			distribution: &cloudfront.Distribution{
+				ARN:                aws.String("arn:aws:cloudfront::123456789012:distribution/E74FTE3EXAMPLE"),
+				DomainName:         aws.String("d111111abcdef8.cloudfront.net"),
+				Status:             aws.String("InProgress"),
+				DistributionConfig: &cloudfront.DistributionConfig{},
+			},
+			expected: `{"aliases":[],"arn":"arn:aws:cloudfront::123456789012:distribution/E74FTE3EXAMPLE","domain_name":"d111111abcdef8.cloudfront.net","status":"InProgress"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			got, err := cloudFrontDistributionSummaryJson(tc.distribution)
+			if err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if got != tc.expected {
+				t.Fatalf("Expected %s, received: %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCloudFrontLoggingBucketRegion(t *testing.T) {
+	cases := []struct {
+		bucket      string
+		region      string
+		unsupported bool
+	}{
+		{"mylogs.s3.amazonaws.com", "", false},
+		{"mylogs.s3.us-west-2.amazonaws.com", "us-west-2", false},
+		{"mylogs.s3-us-west-2.amazonaws.com", "us-west-2", false},
+		{"mylogs.s3.af-south-1.amazonaws.com", "af-south-1", true},
+		{"mylogs.s3-eu-south-1.amazonaws.com", "eu-south-1", true},
+		{"mylogs.s3.dualstack.me-south-1.amazonaws.com", "me-south-1", true},
+		{"mylogs.s3.us-gov-west-1.amazonaws.com", "us-gov-west-1", false},
+		{"", "", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.bucket, func(t *testing.T) {
+			region := cloudFrontLoggingBucketRegion(tc.bucket)
+			if region != tc.region {
+				t.Fatalf("Expected region %q, received: %q", tc.region, region)
+			}
+			if unsupported := cloudFrontLoggingUnsupportedRegions[region]; unsupported != tc.unsupported {
+				t.Fatalf("Expected unsupported to be %t, received: %t", tc.unsupported, unsupported)
+			}
+		})
+	}
+}
+
+func TestValidateCloudFrontUniqueOriginIds(t *testing.T) {
+	withOriginId := func(m map[string]interface{}, id string) map[string]interface{} {
+		m["origin_id"] = id
+		return m
+	}
+
+	cases := []struct {
+		label        string
+		origins      *schema.Set
+		originGroups *schema.Set
+		err          string
+	}{
+		{"unique", multiOriginConf(), originGroupsConf(), ""},
+		{
+			"origin group collides with origin",
+			multiOriginConf(),
+			schema.NewSet(originGroupHash, []interface{}{withOriginId(originGroupConf(), "S3Origin")}),
+			`duplicate origin_id "S3Origin" used by both an origin and an origin_group`,
+		},
+		{
+			"origins collide",
+			schema.NewSet(originHash, []interface{}{originWithCustomConf(), withOriginId(originWithS3Conf(), "CustomOrigin")}),
+			originGroupsConf(),
+			`duplicate origin_id "CustomOrigin" used by more than one origin`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			err := validateCloudFrontUniqueOriginIds(tc.origins, tc.originGroups)
+			if tc.err == "" && err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Fatalf("Expected error %q, received: %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestValidateCloudFrontOriginGroupMembers(t *testing.T) {
+	withMembers := func(m map[string]interface{}, ids ...string) map[string]interface{} {
+		var members []interface{}
+		for _, id := range ids {
+			members = append(members, map[string]interface{}{"origin_id": id})
+		}
+		m["member"] = members
+		return m
+	}
+
+	cases := []struct {
+		label        string
+		originGroups *schema.Set
+		err          string
+	}{
+		{"distinct", originGroupsConf(), ""},
+		{
+			"duplicate member",
+			schema.NewSet(originGroupHash, []interface{}{withMembers(originGroupConf(), "S3Origin", "S3Origin")}),
+			`origin_group (OriginGroup): duplicate member origin_id "S3Origin"`,
+		},
+		{
+			"missing member",
+			schema.NewSet(originGroupHash, []interface{}{withMembers(originGroupConf(), "S3Origin", "MissingOrigin")}),
+			`origin_group (OriginGroup): member origin_id "MissingOrigin" does not match the origin_id of any origin`,
+		},
+		{"unknown member", schema.NewSet(originGroupHash, []interface{}{withMembers(originGroupConf(), "S3Origin", "")}), ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			err := validateCloudFrontOriginGroupMembers(multiOriginConf(), tc.originGroups)
+			if tc.err == "" && err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Fatalf("Expected error %q, received: %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestValidateCloudFrontGeoRestriction(t *testing.T) {
+	cases := []struct {
+		restrictionType string
+		locations       []interface{}
+		valid           bool
+	}{
+		{"none", []interface{}{}, true},
+		{"none", []interface{}{"US"}, false},
+		{"whitelist", []interface{}{"US", "CA"}, true},
+		{"whitelist", []interface{}{}, false},
+		{"blacklist", []interface{}{"US"}, true},
+		{"blacklist", []interface{}{}, false},
+	}
+
+	for _, tc := range cases {
+		m := map[string]interface{}{
+			"restriction_type": tc.restrictionType,
+			"locations":        schema.NewSet(schema.HashString, tc.locations),
+		}
+
+		err := validateCloudFrontGeoRestriction(m)
+		if tc.valid && err != nil {
+			t.Fatalf("%q %v: expected no error, received: %s", tc.restrictionType, tc.locations, err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("%q %v: expected error", tc.restrictionType, tc.locations)
+		}
+	}
+}
+
+func TestCloudFrontDistributionTrustedSignersBehaviors(t *testing.T) {
+	behavior := func(trustedSigners ...interface{}) interface{} {
+		return map[string]interface{}{"trusted_signers": trustedSigners}
+	}
+
+	cases := []struct {
+		label    string
+		dcb      []interface{}
+		ocbKey   string
+		ocb      []interface{}
+		expected []string
+	}{
+		{"none", []interface{}{behavior()}, "ordered_cache_behavior", []interface{}{behavior()}, nil},
+		{"default behavior", []interface{}{behavior("self")}, "ordered_cache_behavior", []interface{}{behavior()}, []string{"default_cache_behavior"}},
+		{"ordered behavior", []interface{}{behavior()}, "ordered_cache_behavior", []interface{}{behavior(), behavior("123456789012")}, []string{"ordered_cache_behavior.1"}},
+		{"ordered behavior by path", []interface{}{behavior()}, "ordered_cache_behavior_by_path", []interface{}{behavior(), behavior("123456789012")}, []string{"ordered_cache_behavior_by_path.1"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			got := cloudFrontDistributionTrustedSignersBehaviors(tc.dcb, tc.ocbKey, tc.ocb)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Fatalf("Expected %v, received: %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResourceAwsCloudFrontDistributionCustomOriginConfigTimeouts(t *testing.T) {
+	customOriginConfig := resourceAwsCloudFrontDistribution().Schema["origin"].Elem.(*schema.Resource).Schema["custom_origin_config"].Elem.(*schema.Resource).Schema
+
+	cases := []struct {
+		key   string
+		value int
+		valid bool
+	}{
+		{"origin_keepalive_timeout", 0, false},
+		{"origin_keepalive_timeout", 1, true},
+		{"origin_keepalive_timeout", 60, true},
+		{"origin_keepalive_timeout", 61, false},
+		{"origin_read_timeout", 0, false},
+		{"origin_read_timeout", 1, true},
+		{"origin_read_timeout", 180, true},
+		{"origin_read_timeout", 181, false},
+	}
+
+	for _, tc := range cases {
+		_, errors := customOriginConfig[tc.key].ValidateFunc(tc.value, tc.key)
+		if tc.valid && len(errors) > 0 {
+			t.Fatalf("%s = %d: expected no errors, received: %v", tc.key, tc.value, errors)
+		}
+		if !tc.valid && len(errors) == 0 {
+			t.Fatalf("%s = %d: expected errors", tc.key, tc.value)
+		}
+	}
+}
+
+func TestValidateCloudFrontCachedMethods(t *testing.T) {
+	cases := []struct {
+		allowedMethods []interface{}
+		cachedMethods  []interface{}
+		err            string
+	}{
+		{[]interface{}{"GET", "HEAD"}, []interface{}{"GET", "HEAD"}, ""},
+		{[]interface{}{"GET", "HEAD", "OPTIONS"}, []interface{}{"GET", "HEAD"}, ""},
+		{[]interface{}{"DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT"}, []interface{}{"GET", "HEAD", "OPTIONS"}, ""},
+		{[]interface{}{}, []interface{}{"GET", "HEAD", "OPTIONS"}, ""},
+		{[]interface{}{"GET", "HEAD"}, []interface{}{"GET", "HEAD", "OPTIONS"}, `cached_methods "OPTIONS" is not one of the allowed_methods`},
+	}
+
+	for _, tc := range cases {
+		err := validateCloudFrontCachedMethods(schema.NewSet(schema.HashString, tc.allowedMethods), schema.NewSet(schema.HashString, tc.cachedMethods))
+		if tc.err == "" && err != nil {
+			t.Fatalf("%v %v: expected no error, received: %s", tc.allowedMethods, tc.cachedMethods, err)
+		}
+		if tc.err != "" && (err == nil || err.Error() != tc.err) {
+			t.Fatalf("%v %v: expected error %q, received: %v", tc.allowedMethods, tc.cachedMethods, tc.err, err)
+		}
+	}
+}
+
+func TestValidateCloudFrontLambdaFunctionAssociations(t *testing.T) {
+	association := func(eventType, lambdaArn string) interface{} {
+		return map[string]interface{}{
+			"event_type":   eventType,
+			"lambda_arn":   lambdaArn,
+			"include_body": false,
+		}
+	}
+
+	cases := []struct {
+		label        string
+		associations []interface{}
+		err          string
+	}{
+		{
+			"unique event types",
+			[]interface{}{
+				association("viewer-request", "arn:aws:lambda:us-east-1:123456789012:function:fn1:1"),
+				association("origin-response", "arn:aws:lambda:us-east-1:123456789012:function:fn1:1"),
+			},
+			"",
+		},
+		{
+			"duplicate event type with different functions",
+			[]interface{}{
+				association("viewer-request", "arn:aws:lambda:us-east-1:123456789012:function:fn1:1"),
+				association("viewer-request", "arn:aws:lambda:us-east-1:123456789012:function:fn2:1"),
+			},
+			`duplicate lambda_function_association event_type "viewer-request"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			err := validateCloudFrontLambdaFunctionAssociations(schema.NewSet(lambdaFunctionAssociationHash, tc.associations))
+			if tc.err == "" && err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Fatalf("Expected error %q, received: %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestValidateCloudFrontOriginConfig(t *testing.T) {
+	s3OriginConfig := []interface{}{
+		map[string]interface{}{"origin_access_identity": "origin-access-identity/cloudfront/E127EXAMPLE51Z"},
+	}
+	customOriginConfig := []interface{}{
+		map[string]interface{}{"origin_protocol_policy": "https-only"},
+	}
+
+	cases := []struct {
+		label  string
+		origin map[string]interface{}
+		err    string
+	}{
+		{"neither", map[string]interface{}{}, ""},
+		{"s3_origin_config", map[string]interface{}{"s3_origin_config": s3OriginConfig, "custom_origin_config": []interface{}{}}, ""},
+		{"custom_origin_config", map[string]interface{}{"s3_origin_config": []interface{}{}, "custom_origin_config": customOriginConfig}, ""},
+		{"both", map[string]interface{}{"s3_origin_config": s3OriginConfig, "custom_origin_config": customOriginConfig}, "s3_origin_config and custom_origin_config conflict, only one can be set"},
+		{"website endpoint custom_origin_config", map[string]interface{}{"domain_name": "example.s3-website-us-east-1.amazonaws.com", "s3_origin_config": []interface{}{}, "custom_origin_config": customOriginConfig}, ""},
+		{"website endpoint s3_origin_config", map[string]interface{}{"domain_name": "example.s3-website-us-east-1.amazonaws.com", "s3_origin_config": s3OriginConfig, "custom_origin_config": []interface{}{}}, `"example.s3-website-us-east-1.amazonaws.com" is an S3 website endpoint, which must be configured with custom_origin_config (origin_protocol_policy = "http-only") instead of s3_origin_config; to use an S3 origin instead, set domain_name to the bucket's bucket_regional_domain_name`},
+		{"website endpoint dot form neither", map[string]interface{}{"domain_name": "example.s3-website.eu-central-1.amazonaws.com"}, `"example.s3-website.eu-central-1.amazonaws.com" is an S3 website endpoint, which must be configured with custom_origin_config (origin_protocol_policy = "http-only") instead of s3_origin_config; to use an S3 origin instead, set domain_name to the bucket's bucket_regional_domain_name`},
+		{"regional domain name s3_origin_config", map[string]interface{}{"domain_name": "example.s3.eu-central-1.amazonaws.com", "s3_origin_config": s3OriginConfig, "custom_origin_config": []interface{}{}}, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			err := validateCloudFrontOriginConfig(tc.origin)
+			if tc.err == "" && err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Fatalf("Expected error %q, received: %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestValidateCloudFrontPathPatterns(t *testing.T) {
+	behaviors := func(pathPatterns ...string) []interface{} {
+		var l []interface{}
+		for _, pathPattern := range pathPatterns {
+			l = append(l, map[string]interface{}{"path_pattern": pathPattern})
+		}
+		return l
+	}
+
+	cases := []struct {
+		label     string
+		behaviors []interface{}
+		err       string
+	}{
+		{"unique", behaviors("images1/*.jpg", "images2/*.jpg"), ""},
+		{"none", behaviors(), ""},
+		{"duplicate", behaviors("images1/*.jpg", "images2/*.jpg", "images1/*.jpg"), `ordered_cache_behavior.2: duplicate path_pattern "images1/*.jpg", also used by ordered_cache_behavior.0`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			err := validateCloudFrontPathPatterns("ordered_cache_behavior", tc.behaviors)
+			if tc.err == "" && err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Fatalf("Expected error %q, received: %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestValidateCloudFrontWebAclId(t *testing.T) {
+	cases := []struct {
+		label string
+		id    string
+		err   string
+	}{
+		{"empty", "", ""},
+		{"classic ID", "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111", ""},
+		{"WAFv2 CLOUDFRONT scope ARN", "arn:aws:wafv2:us-east-1:123456789012:global/webacl/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111", ""},
+		{
+			"WAFv2 REGIONAL scope ARN",
+			"arn:aws:wafv2:us-east-1:123456789012:regional/webacl/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			"web_acl_id (arn:aws:wafv2:us-east-1:123456789012:regional/webacl/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111) is a WAFv2 web ACL with REGIONAL scope, CloudFront requires a web ACL created with CLOUDFRONT scope in us-east-1",
+		},
+		{
+			"WAF Regional ARN",
+			"arn:aws:waf-regional:us-east-1:123456789012:webacl/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			"web_acl_id (arn:aws:waf-regional:us-east-1:123456789012:webacl/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111) is an AWS WAF Regional web ACL, CloudFront requires a global AWS WAF web ACL ID or a WAFv2 web ACL with CLOUDFRONT scope",
+		},
+		{
+			"classic ARN",
+			"arn:aws:waf::123456789012:webacl/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			"web_acl_id (arn:aws:waf::123456789012:webacl/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111) is a classic AWS WAF web ACL ARN, use the web ACL ID (a1b2c3d4-5678-90ab-cdef-EXAMPLE11111) instead",
+		},
+		{
+			"other ARN",
+			"arn:aws:s3:::example",
+			"web_acl_id (arn:aws:s3:::example) must be a classic AWS WAF web ACL ID or a WAFv2 web ACL ARN",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			err := validateCloudFrontWebAclId(tc.id)
+			if tc.err == "" && err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Fatalf("Expected error %q, received: %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestValidateCloudFrontViewerCertificate(t *testing.T) {
+	acmCertificateArn := "arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012"
+	iamCertificateId := "ASCAJLZGIAWNYEXAMPLE"
+
+	cases := []struct {
+		label             string
+		viewerCertificate map[string]interface{}
+		err               string
+	}{
+		{"default certificate", viewerCertificateConfSetCloudFrontDefault(), ""},
+		{"ACM certificate", viewerCertificateConfSetACM(), ""},
+		{"IAM certificate", viewerCertificateConfSetIAM(), ""},
+		{
+			"no certificate",
+			map[string]interface{}{"cloudfront_default_certificate": false},
+			"viewer_certificate: one of acm_certificate_arn or iam_certificate_id must be set when cloudfront_default_certificate is false",
+		},
+		{
+			"ACM and IAM certificates",
+			map[string]interface{}{"acm_certificate_arn": acmCertificateArn, "iam_certificate_id": iamCertificateId},
+			"viewer_certificate: acm_certificate_arn and iam_certificate_id conflict, only one certificate source can be set",
+		},
+		{
+			"default and ACM certificates",
+			map[string]interface{}{"acm_certificate_arn": acmCertificateArn, "cloudfront_default_certificate": true},
+			"viewer_certificate: acm_certificate_arn and cloudfront_default_certificate conflict, only one certificate source can be set",
+		},
+		{
+			"default and IAM certificates",
+			map[string]interface{}{"iam_certificate_id": iamCertificateId, "cloudfront_default_certificate": true},
+			"viewer_certificate: iam_certificate_id and cloudfront_default_certificate conflict, only one certificate source can be set",
+		},
+		{
+			"all certificates",
+			map[string]interface{}{"acm_certificate_arn": acmCertificateArn, "iam_certificate_id": iamCertificateId, "cloudfront_default_certificate": true},
+			"viewer_certificate: acm_certificate_arn and iam_certificate_id and cloudfront_default_certificate conflict, only one certificate source can be set",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			err := validateCloudFrontViewerCertificate(tc.viewerCertificate)
+			if tc.err == "" && err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if tc.err != "" && (err == nil || err.Error() != tc.err) {
+				t.Fatalf("Expected error %q, received: %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestCloudFrontDistributionAliasesUseDefaultCertificate(t *testing.T) {
+	cases := []struct {
+		label             string
+		aliases           []interface{}
+		viewerCertificate map[string]interface{}
+		expected          bool
+	}{
+		{"aliases with default certificate", []interface{}{"example.com"}, viewerCertificateConfSetCloudFrontDefault(), true},
+		{"aliases with ACM certificate", []interface{}{"example.com"}, viewerCertificateConfSetACM(), false},
+		{"aliases with IAM certificate", []interface{}{"example.com"}, viewerCertificateConfSetIAM(), false},
+		{"no aliases with default certificate", []interface{}{}, viewerCertificateConfSetCloudFrontDefault(), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.label, func(t *testing.T) {
+			got := cloudFrontDistributionAliasesUseDefaultCertificate(schema.NewSet(aliasesHash, tc.aliases), []interface{}{tc.viewerCertificate})
+			if got != tc.expected {
+				t.Fatalf("Expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCloudFrontCertificateNameCoversAlias(t *testing.T) {
+	cases := []struct {
+		name     string
+		alias    string
+		expected bool
+	}{
+		{"example.com", "example.com", true},
+		{"Example.com", "example.COM", true},
+		{"example.com", "www.example.com", false},
+		{"*.example.com", "www.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "a.b.example.com", false},
+		{"*.example.com", "*.example.com", true},
+		{"www.example.com", "*.example.com", false},
+	}
+
+	for _, tc := range cases {
+		if got := cloudFrontCertificateNameCoversAlias(tc.name, tc.alias); got != tc.expected {
+			t.Fatalf("%q covers %q: expected %t, got %t", tc.name, tc.alias, tc.expected, got)
+		}
+	}
+}
+
+func TestCloudFrontAliasesNotCoveredByAcmCertificate(t *testing.T) {
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+
+	certificateArn := "arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012"
+	conn := acm.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch params := r.Params.(type) {
+		case *acm.DescribeCertificateInput:
+			if aws.StringValue(params.CertificateArn) != certificateArn {
+				r.Error = awserr.New(acm.ErrCodeResourceNotFoundException, "unexpected ARN", nil)
+				return
+			}
+			r.Data.(*acm.DescribeCertificateOutput).Certificate = &acm.CertificateDetail{
+				CertificateArn:          params.CertificateArn,
+				DomainName:              aws.String("example.com"),
+				SubjectAlternativeNames: aws.StringSlice([]string{"example.com", "*.example.com"}),
+			}
+		}
+	})
+
+	uncovered, err := cloudFrontAliasesNotCoveredByAcmCertificate(conn, certificateArn, []string{"example.com", "new.example.com", "example.org", "a.b.example.com"})
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+	if expected := []string{"example.org", "a.b.example.com"}; !reflect.DeepEqual(uncovered, expected) {
+		t.Fatalf("Expected uncovered aliases %v, got %v", expected, uncovered)
+	}
+
+	if _, err := cloudFrontAliasesNotCoveredByAcmCertificate(conn, "arn:aws:acm:us-east-1:123456789012:certificate/missing", []string{"example.com"}); !isAWSErr(err, acm.ErrCodeResourceNotFoundException, "") {
+		t.Fatalf("Expected %s error, received: %v", acm.ErrCodeResourceNotFoundException, err)
+	}
+}
+
+func TestCloudFrontAcmConnForCertificate(t *testing.T) {
+	certificateArn := "arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012"
+
+	for _, region := range []string{"us-east-1", "eu-west-1"} {
+		t.Run(region, func(t *testing.T) {
+			sess, err := session.NewSession(&aws.Config{Region: aws.String(region)})
+			if err != nil {
+				t.Fatalf("Error new session: %s", err)
+			}
+			providerConn := acm.New(sess)
+
+			conn, err := cloudFrontAcmConnForCertificate(providerConn, certificateArn)
+			if err != nil {
+				t.Fatalf("Expected no error, received: %s", err)
+			}
+			if v := aws.StringValue(conn.Config.Region); v != "us-east-1" {
+				t.Fatalf("Expected client for us-east-1, received: %s", v)
+			}
+			if region == "us-east-1" && conn != providerConn {
+				t.Fatalf("Expected the provider's client to be reused")
+			}
+		})
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("Error new session: %s", err)
+	}
+	if _, err := cloudFrontAcmConnForCertificate(acm.New(sess), "not-an-arn"); err == nil {
+		t.Fatalf("Expected an error for an invalid ARN")
+	}
+}
+
+// TestAccAWSCloudFrontDistribution_S3Origin runs an
+// aws_cloudfront_distribution acceptance test with a single S3 origin.
+//
+// If you are testing manually and can't wait for deletion, set the
+// TF_TEST_CLOUDFRONT_RETAIN environment variable.
+func TestAccAWSCloudFrontDistribution_S3Origin(t *testing.T) {
+	ri := acctest.RandInt()
+	testConfig := fmt.Sprintf(testAccAWSCloudFrontDistributionS3Config, ri, originBucket, logBucket, testAccAWSCloudFrontDistributionRetainConfig())
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(
+						"aws_cloudfront_distribution.s3_distribution",
+					),
+					resource.TestCheckResourceAttr(
+						"aws_cloudfront_distribution.s3_distribution",
+						"hosted_zone_id",
+						"Z2FDTNDATAQYW2",
+					),
+				),
+			},
+			{
+				ResourceName:            "aws_cloudfront_distribution.s3_distribution",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_S3OriginWithTags(t *testing.T) {
+	ri := acctest.RandInt()
+	preConfig := fmt.Sprintf(testAccAWSCloudFrontDistributionS3ConfigWithTags, ri, originBucket, logBucket, testAccAWSCloudFrontDistributionRetainConfig())
+	postConfig := fmt.Sprintf(testAccAWSCloudFrontDistributionS3ConfigWithTagsUpdated, ri, originBucket, logBucket, testAccAWSCloudFrontDistributionRetainConfig())
+	var etag string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(
+						"aws_cloudfront_distribution.s3_distribution",
+					),
+					resource.TestCheckResourceAttr(
+						"aws_cloudfront_distribution.s3_distribution", "tags.%", "2"),
+					resource.TestCheckResourceAttr(
+						"aws_cloudfront_distribution.s3_distribution", "tags.environment", "production"),
+					resource.TestCheckResourceAttr(
+						"aws_cloudfront_distribution.s3_distribution", "tags.account", "main"),
+					testAccCheckCloudFrontDistributionSaveETag("aws_cloudfront_distribution.s3_distribution", &etag),
+				),
+			},
+			{
+				ResourceName:            "aws_cloudfront_distribution.s3_distribution",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(
+						"aws_cloudfront_distribution.s3_distribution",
+					),
+					resource.TestCheckResourceAttr(
+						"aws_cloudfront_distribution.s3_distribution", "tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_cloudfront_distribution.s3_distribution", "tags.environment", "dev"),
+					// A tags-only update must not update (and redeploy) the distribution
+					testAccCheckCloudFrontDistributionETagUnchanged("aws_cloudfront_distribution.s3_distribution", &etag),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAWSCloudFrontDistribution_disappears deletes an enabled
+// distribution through the resource Delete function, which must disable the
+// distribution and wait for the change to deploy before deleting it.
+func TestAccAWSCloudFrontDistribution_disappears(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionNoOptionalItemsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence("aws_cloudfront_distribution.no_optional_items"),
+					resource.TestCheckResourceAttr("aws_cloudfront_distribution.no_optional_items", "enabled", "true"),
+					testAccCheckCloudFrontDistributionDisappears("aws_cloudfront_distribution.no_optional_items"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_S3OriginWithTags_externalChange(t *testing.T) {
+	ri := acctest.RandInt()
+	testConfig := fmt.Sprintf(testAccAWSCloudFrontDistributionS3ConfigWithTags, ri, originBucket, logBucket, testAccAWSCloudFrontDistributionRetainConfig())
+	resourceName := "aws_cloudfront_distribution.s3_distribution"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					testAccCheckCloudFrontDistributionAddTag(resourceName, "external", "true"),
+				),
+				// The externally added tag must be detected as drift
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "tags.external"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAWSCloudFrontDistribution_customOriginruns an
+// aws_cloudfront_distribution acceptance test with a single custom origin.
+//
+// If you are testing manually and can't wait for deletion, set the
+// TF_TEST_CLOUDFRONT_RETAIN environment variable.
+func TestAccAWSCloudFrontDistribution_customOrigin(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionCustomConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(
+						"aws_cloudfront_distribution.custom_distribution",
+					),
+				),
+			},
+			{
+				ResourceName:            "aws_cloudfront_distribution.custom_distribution",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+// TestAccAWSCloudFrontDistribution_multiOrigin runs an
+// aws_cloudfront_distribution acceptance test with multiple origins.
+//
+// If you are testing manually and can't wait for deletion, set the
+// TF_TEST_CLOUDFRONT_RETAIN environment variable.
+func TestAccAWSCloudFrontDistribution_multiOrigin(t *testing.T) {
+	resourceName := "aws_cloudfront_distribution.multi_origin_distribution"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionMultiOriginConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.default_ttl", "50"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.path_pattern", "images1/*.jpg"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+// https://github.com/terraform-providers/terraform-provider-aws/issues/188
+// TestAccAWSCloudFrontDistribution_orderedCacheBehavior runs an
+// aws_cloudfront_distribution acceptance test with multiple and ordered cache behaviors.
+//
+// If you are testing manually and can't wait for deletion, set the
+// TF_TEST_CLOUDFRONT_RETAIN environment variable.
+func TestAccAWSCloudFrontDistribution_orderedCacheBehavior(t *testing.T) {
+	resourceName := "aws_cloudfront_distribution.main"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionOrderedCacheBehavior,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.default_ttl", "50"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.path_pattern", "images1/*.jpg"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.1.default_ttl", "51"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.1.path_pattern", "images2/*.jpg"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+// TestAccAWSCloudFrontDistribution_externalBehaviorDrift changes a cache
+// behavior outside of Terraform and checks that Read picks up the change and
+// the next apply puts it back.
+func TestAccAWSCloudFrontDistribution_externalBehaviorDrift(t *testing.T) {
+	resourceName := "aws_cloudfront_distribution.main"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionOrderedCacheBehavior,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.min_ttl", "50"),
+					testAccCheckCloudFrontDistributionSetOrderedCacheBehaviorMinTtl(resourceName, "images1/*.jpg", 0),
+				),
+				// The externally changed TTL must be detected as drift
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAWSCloudFrontDistributionOrderedCacheBehavior,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.path_pattern", "images1/*.jpg"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.min_ttl", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_Origin_EmptyDomainName(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_Origin_EmptyDomainName,
+				ExpectError: regexp.MustCompile(`domain_name must not be empty`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_Origin_EmptyOriginID(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_Origin_EmptyOriginID,
+				ExpectError: regexp.MustCompile(`origin_id must not be empty`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_OriginGroups(t *testing.T) {
+	resourceName := "aws_cloudfront_distribution.failover_distribution"
+	ri := acctest.RandInt()
+	testConfig := fmt.Sprintf(testAccAWSCloudFrontDistributionOriginGroupsConfig, ri, originBucket, backupBucket, testAccAWSCloudFrontDistributionRetainConfig())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origin_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.target_origin_id", "groupS3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_OriginGroups_CustomFailover(t *testing.T) {
+	resourceName := "aws_cloudfront_distribution.failover_distribution"
+	ri := acctest.RandInt()
+	testConfig := fmt.Sprintf(testAccAWSCloudFrontDistributionOriginGroupsCustomFailoverConfig, ri, originBucket, testAccAWSCloudFrontDistributionRetainConfig())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origin.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "origin_group.#", "1"),
+					testAccCheckCloudFrontDistributionOriginGroup(resourceName, "groupS3Custom", []int64{500, 502, 503, 504}, "primaryS3", "failoverCustom"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.target_origin_id", "groupS3Custom"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_OriginGroups_OrderedCacheBehaviors(t *testing.T) {
+	resourceName := "aws_cloudfront_distribution.failover_distribution"
+	ri := acctest.RandInt()
+	testConfig := fmt.Sprintf(testAccAWSCloudFrontDistributionOriginGroupsOrderedCacheBehaviorsConfig, ri, originBucket, testAccAWSCloudFrontDistributionRetainConfig())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.0.target_origin_id", "groupS3Custom"),
+					resource.TestCheckResourceAttr(resourceName, "ordered_cache_behavior.1.target_origin_id", "groupS3Custom"),
+					testAccCheckCloudFrontDistributionCacheBehaviorTargets(resourceName, "groupS3Custom", "groupS3Custom"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_TargetOriginId_Missing(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_TargetOriginId_Missing,
+				ExpectError: regexp.MustCompile(`target_origin_id "missingOrigin" does not match`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_Origin_DuplicateCustomHeaderName(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_Origin_DuplicateCustomHeaderName,
+				ExpectError: regexp.MustCompile(`duplicate custom_header name "X-Custom-Header"`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_Cookies_WhitelistedNamesWithoutWhitelist(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_Cookies_WhitelistedNamesWithoutWhitelist,
+				ExpectError: regexp.MustCompile(`whitelisted_names can only be set when forward is "whitelist", got "all"`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_GeoRestriction_Invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_GeoRestriction("none", `[ "US" ]`),
+				ExpectError: regexp.MustCompile(`geo_restriction locations must be empty when restriction_type is "none"`),
+			},
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_GeoRestriction("whitelist", `[]`),
+				ExpectError: regexp.MustCompile(`geo_restriction locations must not be empty when restriction_type is "whitelist"`),
+			},
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_GeoRestriction("blacklist", `[]`),
+				ExpectError: regexp.MustCompile(`geo_restriction locations must not be empty when restriction_type is "blacklist"`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_CachedMethods_NotAllowed(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_CachedMethods_NotAllowed,
+				ExpectError: regexp.MustCompile(`default_cache_behavior: cached_methods "OPTIONS" is not one of the allowed_methods`),
+			},
+		},
+	})
+}
+
+// TestAccAWSCloudFrontDistribution_Enabled_False creates a distribution that
+// is disabled from the start.
+func TestAccAWSCloudFrontDistribution_Enabled_False(t *testing.T) {
+	resourceName := "aws_cloudfront_distribution.Enabled_False"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionConfig_Enabled_False,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_Comment_Removed(t *testing.T) {
+	resourceName := "aws_cloudfront_distribution.Comment"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionConfig_Comment("Some comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", "Some comment"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFrontDistributionConfig_Comment(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_DefaultRootObject_Removed(t *testing.T) {
+	resourceName := "aws_cloudfront_distribution.DefaultRootObject"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionConfig_DefaultRootObject(`default_root_object = "index.html"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_root_object", "index.html"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFrontDistributionConfig_DefaultRootObject(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_root_object", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_DefaultRootObject_Invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_DefaultRootObject(`default_root_object = "/index.html"`),
+				ExpectError: regexp.MustCompile(`"default_root_object" \(/index.html\) must not start with a slash`),
+			},
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_DefaultRootObject(fmt.Sprintf("default_root_object = %q", strings.Repeat("a", 256))),
+				ExpectError: regexp.MustCompile(`"default_root_object" cannot be longer than 255 characters, got 256`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_DefaultCacheBehavior_InferredTargetOriginId(t *testing.T) {
+	resourceName := "aws_cloudfront_distribution.InferredTargetOriginId"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionConfig_DefaultCacheBehavior_InferredTargetOriginId,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.target_origin_id", "myCustomOrigin"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_OrderedCacheBehavior_DuplicatePathPattern(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_OrderedCacheBehavior_DuplicatePathPattern,
+				ExpectError: regexp.MustCompile(`duplicate path_pattern "images/\*\.jpg"`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_OriginGroup_InvalidStatusCode(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_OriginGroup_InvalidStatusCode,
+				ExpectError: regexp.MustCompile(`\(501\) must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_OriginGroup_DuplicateOriginId(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_OriginGroup_DuplicateOriginId,
+				ExpectError: regexp.MustCompile(`duplicate origin_id "primaryOrigin" used by both an origin and an origin_group`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_OriginGroup_InvalidMembers(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_OriginGroup_Members("primaryOrigin", "primaryOrigin"),
+				ExpectError: regexp.MustCompile(`origin_group \(originGroup\): duplicate member origin_id "primaryOrigin"`),
+			},
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_OriginGroup_Members("primaryOrigin", "missingOrigin"),
+				ExpectError: regexp.MustCompile(`origin_group \(originGroup\): member origin_id "missingOrigin" does not match the origin_id of any origin`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_ViewerCertificate_Missing(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_ViewerCertificate_Missing,
+				ExpectError: regexp.MustCompile(`one of acm_certificate_arn or iam_certificate_id must be set when cloudfront_default_certificate is false`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_WebAclId_RegionalScope(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_WebAclId_RegionalScope,
+				ExpectError: regexp.MustCompile(`is a WAFv2 web ACL with REGIONAL scope, CloudFront requires a web ACL created with CLOUDFRONT scope`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_Origin_S3AndCustomOriginConfig(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_Origin_S3AndCustomOriginConfig,
+				ExpectError: regexp.MustCompile(`origin \(myOrigin\): s3_origin_config and custom_origin_config conflict`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_Origin_S3WebsiteEndpointS3OriginConfig(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_Origin_S3WebsiteEndpointS3OriginConfig,
+				ExpectError: regexp.MustCompile(`origin \(myOrigin\): "example\.s3-website-us-east-1\.amazonaws\.com" is an S3 website endpoint, which must be configured with custom_origin_config`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_TTL_Invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_TTL(-1, 0),
+				ExpectError: regexp.MustCompile(`expected custom_error_response.\d+.error_caching_min_ttl to be in the range \(0 - 31536000\), got -1`),
+			},
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_TTL(31536001, 0),
+				ExpectError: regexp.MustCompile(`expected custom_error_response.\d+.error_caching_min_ttl to be in the range \(0 - 31536000\), got 31536001`),
+			},
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_TTL(300, -1),
+				ExpectError: regexp.MustCompile(`expected default_cache_behavior.0.min_ttl to be at least \(0\), got -1`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_CustomErrorResponse_InvalidErrorCode(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfig_CustomErrorResponseErrorCode(418),
+				ExpectError: regexp.MustCompile(`"custom_error_response.\d+.error_code" \(418\) must be one of`),
+			},
+		},
+	})
+}
+
+// TestAccAWSCloudFrontDistribution_noOptionalItemsConfig runs an
+// aws_cloudfront_distribution acceptance test with no optional items set.
+//
+// If you are testing manually and can't wait for deletion, set the
+// TF_TEST_CLOUDFRONT_RETAIN environment variable.
+func TestAccAWSCloudFrontDistribution_noOptionalItemsConfig(t *testing.T) {
+	resourceName := "aws_cloudfront_distribution.no_optional_items"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionNoOptionalItemsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "aliases.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "effective_aliases.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "summary_json", regexp.MustCompile(`^\{"aliases":\[\],"arn":"arn:[^"]+:cloudfront::[0-9]{12}:distribution/[A-Z0-9]+","domain_name":"[a-z0-9]+\.cloudfront\.net","status":"(Deployed|InProgress)"\}$`)),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`^[A-Z0-9]+$`)),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:cloudfront::[^:]+:distribution/[A-Z0-9]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "custom_error_response.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.allowed_methods.#", "7"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.cached_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.compress", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.default_ttl", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.0.cookies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.0.cookies.0.forward", "all"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.0.cookies.0.whitelisted_names.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.0.headers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.0.query_string", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.0.query_string_cache_keys.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.lambda_function_association.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.max_ttl", "31536000"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.min_ttl", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.smooth_streaming", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.target_origin_id", "myCustomOrigin"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.trusted_signers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.viewer_protocol_policy", "allow-all"),
+					resource.TestMatchResourceAttr(resourceName, "domain_name", regexp.MustCompile(`^[a-z0-9]+\.cloudfront\.net$`)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexp.MustCompile(`^[A-Z0-9]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "hosted_zone_id", "Z2FDTNDATAQYW2"),
+					resource.TestCheckResourceAttrSet(resourceName, "http_version"),
+					resource.TestCheckResourceAttr(resourceName, "is_ipv6_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "origin.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_header.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.0.http_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.0.https_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.0.origin_keepalive_timeout", "5"),
+					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.0.origin_protocol_policy", "http-only"),
+					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.0.origin_read_timeout", "30"),
+					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.custom_origin_config.0.origin_ssl_protocols.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "origin.1857972443.domain_name", "www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "price_class", "PriceClass_All"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.geo_restriction.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.geo_restriction.0.locations.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "restrictions.0.geo_restriction.0.restriction_type", "whitelist"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "viewer_certificate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "viewer_certificate.0.cloudfront_default_certificate", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSCloudFrontDistributionDomainNameImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+// TestAccAWSCloudFrontDistribution_HTTP11Config runs an
+// aws_cloudfront_distribution acceptance test with the HTTP version set to
+// 1.1.
+//
+// If you are testing manually and can't wait for deletion, set the
+// TF_TEST_CLOUDFRONT_RETAIN environment variable.
+func TestAccAWSCloudFrontDistribution_HTTP11Config(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionHTTP11Config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(
+						"aws_cloudfront_distribution.http_1_1",
+					),
+				),
+			},
+			{
+				ResourceName:            "aws_cloudfront_distribution.http_1_1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_IsIPV6EnabledConfig(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionIsIPV6EnabledConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(
+						"aws_cloudfront_distribution.is_ipv6_enabled",
+					),
+					resource.TestCheckResourceAttr(
+						"aws_cloudfront_distribution.is_ipv6_enabled", "is_ipv6_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            "aws_cloudfront_distribution.is_ipv6_enabled",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+			{
+				Config: testAccAWSCloudFrontDistributionIsIPV6EnabledConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(
+						"aws_cloudfront_distribution.is_ipv6_enabled",
+					),
+					resource.TestCheckResourceAttr(
+						"aws_cloudfront_distribution.is_ipv6_enabled", "is_ipv6_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            "aws_cloudfront_distribution.is_ipv6_enabled",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontDistribution_noCustomErrorResponseConfig(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionNoCustomErroResponseInfo,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExistence(
+						"aws_cloudfront_distribution.no_custom_error_responses",
+					),
+				),
+			},
+			{
+				ResourceName:            "aws_cloudfront_distribution.no_custom_error_responses",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retain_on_delete"},
+			},
+		},
+	})
+}
+
+func testAccAWSCloudFrontDistributionDomainNameImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["domain_name"], nil
+	}
+}
+
+func testAccCheckCloudFrontDistributionDestroy(s *terraform.State) error {
+	for k, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_distribution" {
+			continue
+		}
+		dist, err := testAccAuxCloudFrontGetDistributionConfig(s, k)
+		if err == nil {
+			if _, ok := os.LookupEnv("TF_TEST_CLOUDFRONT_RETAIN"); ok {
+				if *dist.DistributionConfig.Enabled {
+					return fmt.Errorf("CloudFront distribution should be disabled")
+				}
+				return nil
+			}
+			return fmt.Errorf("CloudFront distribution did not destroy")
+		}
+	}
+	return nil
+}
+
+func testAccCheckCloudFrontDistributionExistence(cloudFrontResource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := testAccAuxCloudFrontGetDistributionConfig(s, cloudFrontResource)
+
+		return err
+	}
+}
+
+func testAccCheckCloudFrontDistributionDisappears(cloudFrontResource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		cf, ok := s.RootModule().Resources[cloudFrontResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", cloudFrontResource)
+		}
+
+		r := resourceAwsCloudFrontDistribution()
+		d := r.Data(cf.Primary)
+
+		return r.Delete(d, testAccProvider.Meta())
+	}
+}
+
+// testAccCheckCloudFrontDistributionOriginGroup checks the failover status
+// codes and, in order, the members of the distribution's origin group.
+func testAccCheckCloudFrontDistributionOriginGroup(cloudFrontResource, originGroupId string, statusCodes []int64, memberOriginIds ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		dist, err := testAccAuxCloudFrontGetDistributionConfig(s, cloudFrontResource)
+		if err != nil {
+			return err
+		}
+
+		if dist.DistributionConfig.OriginGroups == nil {
+			return fmt.Errorf("CloudFront distribution has no origin groups")
+		}
+		for _, originGroup := range dist.DistributionConfig.OriginGroups.Items {
+			if aws.StringValue(originGroup.Id) != originGroupId {
+				continue
+			}
+
+			var actualStatusCodes []int64
+			for _, v := range originGroup.FailoverCriteria.StatusCodes.Items {
+				actualStatusCodes = append(actualStatusCodes, aws.Int64Value(v))
+			}
+			sort.Slice(actualStatusCodes, func(i, j int) bool { return actualStatusCodes[i] < actualStatusCodes[j] })
+			if !reflect.DeepEqual(actualStatusCodes, statusCodes) {
+				return fmt.Errorf("CloudFront origin group %q failover status codes are %v, expected %v", originGroupId, actualStatusCodes, statusCodes)
+			}
+
+			var actualMemberOriginIds []string
+			for _, member := range originGroup.Members.Items {
+				actualMemberOriginIds = append(actualMemberOriginIds, aws.StringValue(member.OriginId))
+			}
+			if !reflect.DeepEqual(actualMemberOriginIds, memberOriginIds) {
+				return fmt.Errorf("CloudFront origin group %q members are %v, expected %v", originGroupId, actualMemberOriginIds, memberOriginIds)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("CloudFront origin group %q not found", originGroupId)
+	}
+}
+
+// testAccCheckCloudFrontDistributionCacheBehaviorTargets checks, in order,
+// the target origin IDs of the distribution's ordered cache behaviors.
+func testAccCheckCloudFrontDistributionCacheBehaviorTargets(cloudFrontResource string, targetOriginIds ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		dist, err := testAccAuxCloudFrontGetDistributionConfig(s, cloudFrontResource)
+		if err != nil {
+			return err
+		}
+
+		var actualTargetOriginIds []string
+		if dist.DistributionConfig.CacheBehaviors != nil {
+			for _, cacheBehavior := range dist.DistributionConfig.CacheBehaviors.Items {
+				actualTargetOriginIds = append(actualTargetOriginIds, aws.StringValue(cacheBehavior.TargetOriginId))
+			}
+		}
+		if !reflect.DeepEqual(actualTargetOriginIds, targetOriginIds) {
+			return fmt.Errorf("CloudFront cache behavior targets are %v, expected %v", actualTargetOriginIds, targetOriginIds)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudFrontDistributionAddTag(cloudFrontResource, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		cf, ok := s.RootModule().Resources[cloudFrontResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", cloudFrontResource)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		_, err := conn.TagResource(&cloudfront.TagResourceInput{
+			Resource: aws.String(cf.Primary.Attributes["arn"]),
+			Tags: &cloudfront.Tags{
+				Items: []*cloudfront.Tag{
+					{
+						Key:   aws.String(key),
+						Value: aws.String(value),
+					},
+				},
+			},
+		})
+
+		return err
+	}
+}
+
+// testAccCheckCloudFrontDistributionSetOrderedCacheBehaviorMinTtl changes the
+// min_ttl of the ordered cache behavior with the given path pattern directly
+// through the API.
+func testAccCheckCloudFrontDistributionSetOrderedCacheBehaviorMinTtl(cloudFrontResource, pathPattern string, minTtl int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		cf, ok := s.RootModule().Resources[cloudFrontResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", cloudFrontResource)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		output, err := conn.GetDistributionConfig(&cloudfront.GetDistributionConfigInput{
+			Id: aws.String(cf.Primary.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("Error retrieving CloudFront distribution config: %s", err)
+		}
+
+		found := false
+		if output.DistributionConfig.CacheBehaviors != nil {
+			for _, cacheBehavior := range output.DistributionConfig.CacheBehaviors.Items {
+				if aws.StringValue(cacheBehavior.PathPattern) == pathPattern {
+					cacheBehavior.MinTTL = aws.Int64(minTtl)
+					found = true
+				}
+			}
+		}
+		if !found {
+			return fmt.Errorf("CloudFront cache behavior %q not found", pathPattern)
+		}
+
+		return resourceAwsCloudFrontDistributionUpdateDistribution(conn, &cloudfront.UpdateDistributionInput{
+			Id:                 aws.String(cf.Primary.ID),
+			DistributionConfig: output.DistributionConfig,
+			IfMatch:            output.ETag,
+		})
+	}
+}
+
+func testAccCheckCloudFrontDistributionSaveETag(cloudFrontResource string, etag *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		cf, ok := s.RootModule().Resources[cloudFrontResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", cloudFrontResource)
+		}
+
+		*etag = cf.Primary.Attributes["etag"]
+
+		return nil
+	}
+}
+
+func testAccCheckCloudFrontDistributionETagUnchanged(cloudFrontResource string, etag *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		cf, ok := s.RootModule().Resources[cloudFrontResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", cloudFrontResource)
+		}
+
+		if v := cf.Primary.Attributes["etag"]; v != *etag {
+			return fmt.Errorf("CloudFront distribution ETag changed from %q to %q", *etag, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccAuxCloudFrontGetDistributionConfig(s *terraform.State, cloudFrontResource string) (*cloudfront.Distribution, error) {
+	cf, ok := s.RootModule().Resources[cloudFrontResource]
+	if !ok {
+		return nil, fmt.Errorf("Not found: %s", cloudFrontResource)
+	}
+
+	if cf.Primary.ID == "" {
+		return nil, fmt.Errorf("No Id is set")
+	}
+
+	cloudfrontconn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+	req := &cloudfront.GetDistributionInput{
+		Id: aws.String(cf.Primary.ID),
+	}
+
+	res, err := cloudfrontconn.GetDistribution(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving CloudFront distribution: %s", err)
+	}
+
+	return res.Distribution, nil
+}
+
+func testAccAWSCloudFrontDistributionRetainConfig() string {
+	if _, ok := os.LookupEnv("TF_TEST_CLOUDFRONT_RETAIN"); ok {
+		return "retain_on_delete = true"
+	}
+	return ""
+}
+
+var originBucket = fmt.Sprintf(`
+resource "aws_s3_bucket" "s3_bucket_origin" {
+	bucket = "mybucket.${var.rand_id}"
+	acl = "public-read"
+}
+`)
+
+var logBucket = fmt.Sprintf(`
+resource "aws_s3_bucket" "s3_bucket_logs" {
+	bucket = "mylogs.${var.rand_id}"
+	acl = "public-read"
+}
+`)
+
+var backupBucket = fmt.Sprintf(`
+resource "aws_s3_bucket" "s3_backup_bucket_origin" {
+	bucket = "mybucket-backup.${var.rand_id}"
+	acl = "public-read"
+}
+`)
+
+var testAccAWSCloudFrontDistributionS3Config = `
+variable rand_id {
+	default = %d
+}
+
+# origin bucket
+%s
+
+# log bucket
+%s
+
+resource "aws_cloudfront_distribution" "s3_distribution" {
+	origin {
+		domain_name = "${aws_s3_bucket.s3_bucket_origin.id}.s3.amazonaws.com"
+		origin_id = "myS3Origin"
+	}
+	enabled = true
+	default_root_object = "index.html"
+	logging_config {
+		include_cookies = false
+		bucket = "${aws_s3_bucket.s3_bucket_logs.id}.s3.amazonaws.com"
+		prefix = "myprefix"
+	}
+	aliases = [ "mysite.${var.rand_id}.example.com", "yoursite.${var.rand_id}.example.com" ]
+	default_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myS3Origin"
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "none"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+		min_ttl = 0
+		default_ttl = 3600
+		max_ttl = 86400
+	}
+	price_class = "PriceClass_200"
+	restrictions {
+		geo_restriction {
+			restriction_type = "whitelist"
+			locations = [ "US", "CA", "GB", "DE" ]
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	%s
+}
+`
+
+var testAccAWSCloudFrontDistributionS3ConfigWithTags = `
+variable rand_id {
+	default = %d
+}
+
+# origin bucket
+%s
+
+# log bucket
+%s
+
+resource "aws_cloudfront_distribution" "s3_distribution" {
+	origin {
+		domain_name = "${aws_s3_bucket.s3_bucket_origin.id}.s3.amazonaws.com"
+		origin_id = "myS3Origin"
+	}
+	enabled = true
+	default_root_object = "index.html"
+	aliases = [ "mysite.${var.rand_id}.example.com", "yoursite.${var.rand_id}.example.com" ]
+	default_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myS3Origin"
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "none"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+		min_ttl = 0
+		default_ttl = 3600
+		max_ttl = 86400
+	}
+	price_class = "PriceClass_200"
+	restrictions {
+		geo_restriction {
+			restriction_type = "whitelist"
+			locations = [ "US", "CA", "GB", "DE" ]
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	tags = {
+            environment = "production"
+            account = "main"
+	}
+	%s
+}
+`
+
+var testAccAWSCloudFrontDistributionS3ConfigWithTagsUpdated = `
+variable rand_id {
+	default = %d
+}
+
+# origin bucket
+%s
+
+# log bucket
+%s
+
+resource "aws_cloudfront_distribution" "s3_distribution" {
+	origin {
+		domain_name = "${aws_s3_bucket.s3_bucket_origin.id}.s3.amazonaws.com"
+		origin_id = "myS3Origin"
+	}
+	enabled = true
+	default_root_object = "index.html"
+	aliases = [ "mysite.${var.rand_id}.example.com", "yoursite.${var.rand_id}.example.com" ]
+	default_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myS3Origin"
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "none"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+		min_ttl = 0
+		default_ttl = 3600
+		max_ttl = 86400
+	}
+	price_class = "PriceClass_200"
+	restrictions {
+		geo_restriction {
+			restriction_type = "whitelist"
+			locations = [ "US", "CA", "GB", "DE" ]
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	tags = {
+            environment = "dev"
+	}
+	%s
+}
+`
+
+var testAccAWSCloudFrontDistributionCustomConfig = fmt.Sprintf(`
+variable rand_id {
+	default = %d
+}
+
+# log bucket
+%s
+
+resource "aws_cloudfront_distribution" "custom_distribution" {
+	origin {
+		domain_name = "www.example.com"
+		origin_id = "myCustomOrigin"
+		custom_origin_config {
+			http_port = 80
+			https_port = 443
+			origin_protocol_policy = "http-only"
+			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
+			origin_read_timeout = 30
+			origin_keepalive_timeout = 5
+		}
+	}
+	enabled = true
+	comment = "Some comment"
+	default_root_object = "index.html"
+	logging_config {
+		include_cookies = false
+		bucket = "${aws_s3_bucket.s3_bucket_logs.id}.s3.amazonaws.com"
+		prefix = "myprefix"
+	}
+	aliases = [ "mysite.${var.rand_id}.example.com", "*.yoursite.${var.rand_id}.example.com" ]
+	default_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myCustomOrigin"
+		smooth_streaming = false
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "all"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+		min_ttl = 0
+		default_ttl = 3600
+		max_ttl = 86400
+	}
+	price_class = "PriceClass_200"
+	restrictions {
+		geo_restriction {
+			restriction_type = "whitelist"
+			locations = [ "US", "CA", "GB", "DE" ]
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	%s
+}
+`, acctest.RandInt(), logBucket, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionMultiOriginConfig = fmt.Sprintf(`
+variable rand_id {
+	default = %d
+}
+
+# origin bucket
+%s
+
+# log bucket
+%s
+
+resource "aws_cloudfront_distribution" "multi_origin_distribution" {
+	origin {
+		domain_name = "${aws_s3_bucket.s3_bucket_origin.id}.s3.amazonaws.com"
+		origin_id = "myS3Origin"
+	}
+	origin {
+		domain_name = "www.example.com"
+		origin_id = "myCustomOrigin"
+		custom_origin_config {
+			http_port = 80
+			https_port = 443
+			origin_protocol_policy = "http-only"
+			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
+			origin_keepalive_timeout = 45
+		}
+	}
+	enabled = true
+	comment = "Some comment"
+	default_root_object = "index.html"
+	logging_config {
+		include_cookies = false
+		bucket = "${aws_s3_bucket.s3_bucket_logs.id}.s3.amazonaws.com"
+		prefix = "myprefix"
+	}
+	aliases = [ "mysite.${var.rand_id}.example.com", "*.yoursite.${var.rand_id}.example.com" ]
+	default_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myS3Origin"
+		smooth_streaming = true
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "all"
+			}
+		}
+		min_ttl = 100
+		default_ttl = 100
+		max_ttl = 100
+		viewer_protocol_policy = "allow-all"
+	}
+	ordered_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myS3Origin"
+		forwarded_values {
+			query_string = true
+			cookies {
+				forward = "none"
+			}
+		}
+		min_ttl = 50
+		default_ttl = 50
+		max_ttl = 50
+		viewer_protocol_policy = "allow-all"
+		path_pattern = "images1/*.jpg"
+	}
+	ordered_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myCustomOrigin"
+		forwarded_values {
+			query_string = true
+			cookies {
+				forward = "none"
+			}
+		}
+		min_ttl = 50
+		default_ttl = 50
+		max_ttl = 50
+		viewer_protocol_policy = "allow-all"
+		path_pattern = "images2/*.jpg"
+	}
+
+	price_class = "PriceClass_All"
+	custom_error_response {
+		error_code = 404
+		response_page_path = "/error-pages/404.html"
+		response_code = 200
+		error_caching_min_ttl = 30
+	}
+	restrictions {
+		geo_restriction {
+			restriction_type = "none"
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	%s
+}
+`, acctest.RandInt(), originBucket, logBucket, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionNoCustomErroResponseInfo = fmt.Sprintf(`
+variable rand_id {
+	default = %d
+}
+
+resource "aws_cloudfront_distribution" "no_custom_error_responses" {
+	origin {
+		domain_name = "www.example.com"
+		origin_id = "myCustomOrigin"
+		custom_origin_config {
+			http_port = 80
+			https_port = 443
+			origin_protocol_policy = "http-only"
+			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
+		}
+	}
+	enabled = true
+	comment = "Some comment"
+	default_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myCustomOrigin"
+		smooth_streaming = false
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "all"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+		min_ttl = 0
+		default_ttl = 3600
+		max_ttl = 86400
+	}
+	custom_error_response {
+		error_code = 404
+		error_caching_min_ttl = 30
+	}
+	restrictions {
+		geo_restriction {
+			restriction_type = "whitelist"
+			locations = [ "US", "CA", "GB", "DE" ]
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	%s
+}
+`, acctest.RandInt(), testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionNoOptionalItemsConfig = fmt.Sprintf(`
+variable rand_id {
+	default = %d
+}
+
+resource "aws_cloudfront_distribution" "no_optional_items" {
+	origin {
+		domain_name = "www.example.com"
+		origin_id = "myCustomOrigin"
+		custom_origin_config {
+			http_port = 80
+			https_port = 443
+			origin_protocol_policy = "http-only"
+			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
+		}
+	}
+	enabled = true
+	default_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myCustomOrigin"
+		smooth_streaming = false
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "all"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+	}
+	restrictions {
+		geo_restriction {
+			restriction_type = "whitelist"
+			locations = [ "US", "CA", "GB", "DE" ]
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	%s
+}
+`, acctest.RandInt(), testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionConfig_Origin_EmptyDomainName = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "Origin_EmptyDomainName" {
+  origin {
+    domain_name = ""
+    origin_id   = "myCustomOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myCustomOrigin"
+    smooth_streaming = false
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "whitelist"
+      locations        = [ "US", "CA", "GB", "DE" ]
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionConfig_Origin_EmptyOriginID = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "Origin_EmptyOriginID" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = ""
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myCustomOrigin"
+    smooth_streaming = false
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "whitelist"
+      locations        = [ "US", "CA", "GB", "DE" ]
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionOriginGroupsConfig = `
+variable rand_id {
+	default = %d
+}
+
+# origin bucket
+%s
+
+# backup origin bucket
+%s
+
+resource "aws_cloudfront_distribution" "failover_distribution" {
+	origin {
+		domain_name = "${aws_s3_bucket.s3_bucket_origin.bucket_regional_domain_name}"
+		origin_id = "primaryS3"
+	}
+	origin {
+		domain_name = "${aws_s3_bucket.s3_backup_bucket_origin.bucket_regional_domain_name}"
+		origin_id = "failoverS3"
+	}
+	origin_group {
+		origin_id = "groupS3"
+		failover_criteria {
+			status_codes = [403, 404, 500, 502]
+		}
+		member {
+			origin_id = "primaryS3"
+		}
+		member {
+			origin_id = "failoverS3"
+		}
+	}
+	enabled = true
+	default_cache_behavior {
+		allowed_methods = [ "GET", "HEAD" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "groupS3"
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "none"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+	}
+	restrictions {
+		geo_restriction {
+			restriction_type = "none"
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	%s
+}
+`
+
+var testAccAWSCloudFrontDistributionOriginGroupsCustomFailoverConfig = `
+variable rand_id {
+	default = %d
+}
+
+# origin bucket
+%s
+
+resource "aws_cloudfront_distribution" "failover_distribution" {
+	origin {
+		domain_name = "${aws_s3_bucket.s3_bucket_origin.bucket_regional_domain_name}"
+		origin_id = "primaryS3"
+	}
+	origin {
+		domain_name = "www.example.com"
+		origin_id = "failoverCustom"
+		custom_origin_config {
+			http_port = 80
+			https_port = 443
+			origin_protocol_policy = "http-only"
+			origin_ssl_protocols = [ "TLSv1.2" ]
+		}
+	}
+	origin_group {
+		origin_id = "groupS3Custom"
+		failover_criteria {
+			status_codes = [500, 502, 503, 504]
+		}
+		member {
+			origin_id = "primaryS3"
+		}
+		member {
+			origin_id = "failoverCustom"
+		}
+	}
+	enabled = true
+	default_cache_behavior {
+		allowed_methods = [ "GET", "HEAD" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "groupS3Custom"
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "none"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+	}
+	restrictions {
+		geo_restriction {
+			restriction_type = "none"
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	%s
+}
+`
+
+var testAccAWSCloudFrontDistributionOriginGroupsOrderedCacheBehaviorsConfig = `
+variable rand_id {
+	default = %d
+}
+
+# origin bucket
+%s
+
+resource "aws_cloudfront_distribution" "failover_distribution" {
+	origin {
+		domain_name = "${aws_s3_bucket.s3_bucket_origin.bucket_regional_domain_name}"
+		origin_id = "primaryS3"
+	}
+	origin {
+		domain_name = "www.example.com"
+		origin_id = "failoverCustom"
+		custom_origin_config {
+			http_port = 80
+			https_port = 443
+			origin_protocol_policy = "http-only"
+			origin_ssl_protocols = [ "TLSv1.2" ]
+		}
+	}
+	origin_group {
+		origin_id = "groupS3Custom"
+		failover_criteria {
+			status_codes = [500, 502, 503, 504]
+		}
+		member {
+			origin_id = "primaryS3"
+		}
+		member {
+			origin_id = "failoverCustom"
+		}
+	}
+	enabled = true
+	default_cache_behavior {
+		allowed_methods = [ "GET", "HEAD" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "primaryS3"
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "none"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+	}
+	ordered_cache_behavior {
+		allowed_methods = [ "GET", "HEAD" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "groupS3Custom"
+		path_pattern = "images/*"
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "none"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+	}
+	ordered_cache_behavior {
+		allowed_methods = [ "GET", "HEAD" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "groupS3Custom"
+		path_pattern = "videos/*"
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "none"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+	}
+	restrictions {
+		geo_restriction {
+			restriction_type = "none"
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	%s
+}
+`
+
+var testAccAWSCloudFrontDistributionConfig_TargetOriginId_Missing = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "TargetOriginId_Missing" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "missingOrigin"
+    smooth_streaming = false
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "whitelist"
+      locations        = [ "US", "CA", "GB", "DE" ]
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionConfig_Origin_DuplicateCustomHeaderName = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "Origin_DuplicateCustomHeaderName" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+    custom_header {
+      name  = "X-Custom-Header"
+      value = "value1"
+    }
+    custom_header {
+      name  = "X-Custom-Header"
+      value = "value2"
+    }
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myCustomOrigin"
+    smooth_streaming = false
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "whitelist"
+      locations        = [ "US", "CA", "GB", "DE" ]
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionHTTP11Config = fmt.Sprintf(`
+variable rand_id {
+	default = %d
+}
+
+resource "aws_cloudfront_distribution" "http_1_1" {
+	origin {
+		domain_name = "www.example.com"
+		origin_id = "myCustomOrigin"
+		custom_origin_config {
+			http_port = 80
+			https_port = 443
+			origin_protocol_policy = "http-only"
+			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
+		}
+	}
+	enabled = true
+	comment = "Some comment"
+	default_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myCustomOrigin"
+		smooth_streaming = false
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "all"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+		min_ttl = 0
+		default_ttl = 3600
+		max_ttl = 86400
+	}
+	http_version = "http1.1"
+	restrictions {
+		geo_restriction {
+			restriction_type = "whitelist"
+			locations = [ "US", "CA", "GB", "DE" ]
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	%s
+}
+`, acctest.RandInt(), testAccAWSCloudFrontDistributionRetainConfig())
+
+func testAccAWSCloudFrontDistributionIsIPV6EnabledConfig(isIPV6Enabled bool) string {
+	return fmt.Sprintf(`
+variable rand_id {
+	default = %d
+}
+
+resource "aws_cloudfront_distribution" "is_ipv6_enabled" {
+	origin {
+		domain_name = "www.example.com"
+		origin_id = "myCustomOrigin"
+		custom_origin_config {
+			http_port = 80
+			https_port = 443
+			origin_protocol_policy = "http-only"
+			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
+		}
+	}
+	enabled = true
+	is_ipv6_enabled = %t
+	comment = "Some comment"
+	default_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myCustomOrigin"
+		smooth_streaming = false
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "all"
+			}
+		}
+		viewer_protocol_policy = "allow-all"
+		min_ttl = 0
+		default_ttl = 3600
+		max_ttl = 86400
+	}
+	http_version = "http1.1"
+	restrictions {
+		geo_restriction {
+			restriction_type = "whitelist"
+			locations = [ "US", "CA", "GB", "DE" ]
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	%s
+}
+`, acctest.RandInt(), isIPV6Enabled, testAccAWSCloudFrontDistributionRetainConfig())
+}
+
+var testAccAWSCloudFrontDistributionOrderedCacheBehavior = fmt.Sprintf(`
+variable rand_id {
+	default = %d
+}
+
+resource "aws_cloudfront_distribution" "main" {
+	origin {
+		domain_name = "www.hashicorp.com"
+		origin_id = "myCustomOrigin"
+		custom_origin_config {
+			http_port = 80
+			https_port = 443
+			origin_protocol_policy = "http-only"
+			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
+		}
+	}
+	enabled = true
+	comment = "Some comment"
+	aliases = []
+	default_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myCustomOrigin"
+		smooth_streaming = true
+		forwarded_values {
+			query_string = false
+			cookies {
+				forward = "all"
+			}
+		}
+		min_ttl = 100
+		default_ttl = 100
+		max_ttl = 100
+		viewer_protocol_policy = "allow-all"
+	}
+	ordered_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myCustomOrigin"
+		forwarded_values {
+			query_string = true
+			cookies {
+				forward = "none"
+			}
+		}
+		min_ttl = 50
+		default_ttl = 50
+		max_ttl = 50
+		viewer_protocol_policy = "allow-all"
+		path_pattern = "images1/*.jpg"
+	}
+	ordered_cache_behavior {
+		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+		cached_methods = [ "GET", "HEAD" ]
+		target_origin_id = "myCustomOrigin"
+		forwarded_values {
+			query_string = true
+			cookies {
+				forward = "none"
+			}
+		}
+		min_ttl = 51
+		default_ttl = 51
+		max_ttl = 51
+		viewer_protocol_policy = "allow-all"
+		path_pattern = "images2/*.jpg"
+	}
+
+	price_class = "PriceClass_All"
+	restrictions {
+		geo_restriction {
+			restriction_type = "none"
+		}
+	}
+	viewer_certificate {
+		cloudfront_default_certificate = true
+	}
+	%s
+}
+`, acctest.RandInt(), testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionConfig_Cookies_WhitelistedNamesWithoutWhitelist = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "Cookies_WhitelistedNamesWithoutWhitelist" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myCustomOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward           = "all"
+        whitelisted_names = [ "session" ]
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionConfig_CachedMethods_NotAllowed = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "CachedMethods_NotAllowed" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD", "OPTIONS" ]
+    target_origin_id = "myCustomOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionConfig_Enabled_False = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "Enabled_False" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = false
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myCustomOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionConfig_OrderedCacheBehavior_DuplicatePathPattern = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "OrderedCacheBehavior_DuplicatePathPattern" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myCustomOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  ordered_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    path_pattern     = "images/*.jpg"
+    target_origin_id = "myCustomOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "none"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  ordered_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    path_pattern     = "images/*.jpg"
+    target_origin_id = "myCustomOrigin"
+    forwarded_values {
+      query_string = true
+      cookies {
+        forward = "none"
+      }
+    }
+    viewer_protocol_policy = "redirect-to-https"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+func testAccAWSCloudFrontDistributionConfig_Comment(comment string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "Comment" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = true
+  comment = %q
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myCustomOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, comment, testAccAWSCloudFrontDistributionRetainConfig())
+}
+
+func testAccAWSCloudFrontDistributionConfig_DefaultRootObject(defaultRootObject string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "DefaultRootObject" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = true
+  %s
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myCustomOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, defaultRootObject, testAccAWSCloudFrontDistributionRetainConfig())
+}
+
+var testAccAWSCloudFrontDistributionConfig_OriginGroup_InvalidStatusCode = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "OriginGroup_InvalidStatusCode" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "primaryOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  origin {
+    domain_name = "backup.example.com"
+    origin_id   = "failoverOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  origin_group {
+    origin_id = "group"
+    failover_criteria {
+      status_codes = [500, 501]
+    }
+    member {
+      origin_id = "primaryOrigin"
+    }
+    member {
+      origin_id = "failoverOrigin"
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "group"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionConfig_DefaultCacheBehavior_InferredTargetOriginId = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "InferredTargetOriginId" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods = [ "GET", "HEAD" ]
+    cached_methods  = [ "GET", "HEAD" ]
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionConfig_OriginGroup_DuplicateOriginId = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "OriginGroup_DuplicateOriginId" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "primaryOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  origin {
+    domain_name = "backup.example.com"
+    origin_id   = "failoverOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  origin_group {
+    origin_id = "primaryOrigin"
+    failover_criteria {
+      status_codes = [500, 502]
+    }
+    member {
+      origin_id = "primaryOrigin"
+    }
+    member {
+      origin_id = "failoverOrigin"
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "primaryOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+func testAccAWSCloudFrontDistributionConfig_OriginGroup_Members(primaryOriginId, secondaryOriginId string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "OriginGroup_Members" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "primaryOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  origin {
+    domain_name = "backup.example.com"
+    origin_id   = "failoverOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  origin_group {
+    origin_id = "originGroup"
+    failover_criteria {
+      status_codes = [500, 502]
+    }
+    member {
+      origin_id = %q
+    }
+    member {
+      origin_id = %q
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "originGroup"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, primaryOriginId, secondaryOriginId, testAccAWSCloudFrontDistributionRetainConfig())
+}
+
+var testAccAWSCloudFrontDistributionConfig_WebAclId_RegionalScope = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "WebAclId_RegionalScope" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled    = true
+  web_acl_id = "arn:aws:wafv2:us-east-1:123456789012:regional/webacl/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myCustomOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionConfig_ViewerCertificate_Missing = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "ViewerCertificate_Missing" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myCustomOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    minimum_protocol_version = "TLSv1"
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+var testAccAWSCloudFrontDistributionConfig_Origin_S3AndCustomOriginConfig = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "Origin_S3AndCustomOriginConfig" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+    s3_origin_config {
+      origin_access_identity = "origin-access-identity/cloudfront/E127EXAMPLE51Z"
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
 }
-`, acctest.RandInt(), testAccAWSCloudFrontDistributionRetainConfig())
+`, testAccAWSCloudFrontDistributionRetainConfig())
 
-var testAccAWSCloudFrontDistributionConfig_Origin_EmptyDomainName = fmt.Sprintf(`
-resource "aws_cloudfront_distribution" "Origin_EmptyDomainName" {
+var testAccAWSCloudFrontDistributionConfig_Origin_S3WebsiteEndpointS3OriginConfig = fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "Origin_S3WebsiteEndpointS3OriginConfig" {
   origin {
-    domain_name = ""
+    domain_name = "example.s3-website-us-east-1.amazonaws.com"
+    origin_id   = "myOrigin"
+    s3_origin_config {
+      origin_access_identity = "origin-access-identity/cloudfront/E127EXAMPLE51Z"
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
+}
+`, testAccAWSCloudFrontDistributionRetainConfig())
+
+func testAccAWSCloudFrontDistributionConfig_TTL(errorCachingMinTtl, minTtl int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "TTL" {
+  origin {
+    domain_name = "www.example.com"
     origin_id   = "myCustomOrigin"
     custom_origin_config {
       http_port              = 80
@@ -933,11 +5800,15 @@ resource "aws_cloudfront_distribution" "Origin_EmptyDomainName" {
     }
   }
   enabled = true
+  custom_error_response {
+    error_code            = 404
+    error_caching_min_ttl = %d
+  }
   default_cache_behavior {
-    allowed_methods  = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+    allowed_methods  = [ "GET", "HEAD" ]
     cached_methods   = [ "GET", "HEAD" ]
     target_origin_id = "myCustomOrigin"
-    smooth_streaming = false
+    min_ttl          = %d
     forwarded_values {
       query_string = false
       cookies {
@@ -948,8 +5819,7 @@ resource "aws_cloudfront_distribution" "Origin_EmptyDomainName" {
   }
   restrictions {
     geo_restriction {
-      restriction_type = "whitelist"
-      locations        = [ "US", "CA", "GB", "DE" ]
+      restriction_type = "none"
     }
   }
   viewer_certificate {
@@ -957,13 +5827,15 @@ resource "aws_cloudfront_distribution" "Origin_EmptyDomainName" {
   }
   %s
 }
-`, testAccAWSCloudFrontDistributionRetainConfig())
+`, errorCachingMinTtl, minTtl, testAccAWSCloudFrontDistributionRetainConfig())
+}
 
-var testAccAWSCloudFrontDistributionConfig_Origin_EmptyOriginID = fmt.Sprintf(`
-resource "aws_cloudfront_distribution" "Origin_EmptyOriginID" {
+func testAccAWSCloudFrontDistributionConfig_CustomErrorResponseErrorCode(errorCode int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "CustomErrorResponse" {
   origin {
     domain_name = "www.example.com"
-    origin_id   = ""
+    origin_id   = "myCustomOrigin"
     custom_origin_config {
       http_port              = 80
       https_port             = 443
@@ -972,11 +5844,13 @@ resource "aws_cloudfront_distribution" "Origin_EmptyOriginID" {
     }
   }
   enabled = true
+  custom_error_response {
+    error_code = %d
+  }
   default_cache_behavior {
-    allowed_methods  = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
+    allowed_methods  = [ "GET", "HEAD" ]
     cached_methods   = [ "GET", "HEAD" ]
     target_origin_id = "myCustomOrigin"
-    smooth_streaming = false
     forwarded_values {
       query_string = false
       cookies {
@@ -987,8 +5861,7 @@ resource "aws_cloudfront_distribution" "Origin_EmptyOriginID" {
   }
   restrictions {
     geo_restriction {
-      restriction_type = "whitelist"
-      locations        = [ "US", "CA", "GB", "DE" ]
+      restriction_type = "none"
     }
   }
   viewer_certificate {
@@ -996,182 +5869,45 @@ resource "aws_cloudfront_distribution" "Origin_EmptyOriginID" {
   }
   %s
 }
-`, testAccAWSCloudFrontDistributionRetainConfig())
-
-var testAccAWSCloudFrontDistributionHTTP11Config = fmt.Sprintf(`
-variable rand_id {
-	default = %d
-}
-
-resource "aws_cloudfront_distribution" "http_1_1" {
-	origin {
-		domain_name = "www.example.com"
-		origin_id = "myCustomOrigin"
-		custom_origin_config {
-			http_port = 80
-			https_port = 443
-			origin_protocol_policy = "http-only"
-			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
-		}
-	}
-	enabled = true
-	comment = "Some comment"
-	default_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myCustomOrigin"
-		smooth_streaming = false
-		forwarded_values {
-			query_string = false
-			cookies {
-				forward = "all"
-			}
-		}
-		viewer_protocol_policy = "allow-all"
-		min_ttl = 0
-		default_ttl = 3600
-		max_ttl = 86400
-	}
-	http_version = "http1.1"
-	restrictions {
-		geo_restriction {
-			restriction_type = "whitelist"
-			locations = [ "US", "CA", "GB", "DE" ]
-		}
-	}
-	viewer_certificate {
-		cloudfront_default_certificate = true
-	}
-	%s
-}
-`, acctest.RandInt(), testAccAWSCloudFrontDistributionRetainConfig())
-
-var testAccAWSCloudFrontDistributionIsIPV6EnabledConfig = fmt.Sprintf(`
-variable rand_id {
-	default = %d
-}
-
-resource "aws_cloudfront_distribution" "is_ipv6_enabled" {
-	origin {
-		domain_name = "www.example.com"
-		origin_id = "myCustomOrigin"
-		custom_origin_config {
-			http_port = 80
-			https_port = 443
-			origin_protocol_policy = "http-only"
-			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
-		}
-	}
-	enabled = true
-	is_ipv6_enabled = true
-	comment = "Some comment"
-	default_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myCustomOrigin"
-		smooth_streaming = false
-		forwarded_values {
-			query_string = false
-			cookies {
-				forward = "all"
-			}
-		}
-		viewer_protocol_policy = "allow-all"
-		min_ttl = 0
-		default_ttl = 3600
-		max_ttl = 86400
-	}
-	http_version = "http1.1"
-	restrictions {
-		geo_restriction {
-			restriction_type = "whitelist"
-			locations = [ "US", "CA", "GB", "DE" ]
-		}
-	}
-	viewer_certificate {
-		cloudfront_default_certificate = true
-	}
-	%s
+`, errorCode, testAccAWSCloudFrontDistributionRetainConfig())
 }
-`, acctest.RandInt(), testAccAWSCloudFrontDistributionRetainConfig())
 
-var testAccAWSCloudFrontDistributionOrderedCacheBehavior = fmt.Sprintf(`
-variable rand_id {
-	default = %d
+func testAccAWSCloudFrontDistributionConfig_GeoRestriction(restrictionType, locations string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" "GeoRestriction" {
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "myCustomOrigin"
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "http-only"
+      origin_ssl_protocols   = [ "SSLv3", "TLSv1" ]
+    }
+  }
+  enabled = true
+  default_cache_behavior {
+    allowed_methods  = [ "GET", "HEAD" ]
+    cached_methods   = [ "GET", "HEAD" ]
+    target_origin_id = "myCustomOrigin"
+    forwarded_values {
+      query_string = false
+      cookies {
+        forward = "all"
+      }
+    }
+    viewer_protocol_policy = "allow-all"
+  }
+  restrictions {
+    geo_restriction {
+      restriction_type = %q
+      locations        = %s
+    }
+  }
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+  %s
 }
-
-resource "aws_cloudfront_distribution" "main" {
-	origin {
-		domain_name = "www.hashicorp.com"
-		origin_id = "myCustomOrigin"
-		custom_origin_config {
-			http_port = 80
-			https_port = 443
-			origin_protocol_policy = "http-only"
-			origin_ssl_protocols = [ "SSLv3", "TLSv1" ]
-		}
-	}
-	enabled = true
-	comment = "Some comment"
-	aliases = []
-	default_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myCustomOrigin"
-		smooth_streaming = true
-		forwarded_values {
-			query_string = false
-			cookies {
-				forward = "all"
-			}
-		}
-		min_ttl = 100
-		default_ttl = 100
-		max_ttl = 100
-		viewer_protocol_policy = "allow-all"
-	}
-	ordered_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myCustomOrigin"
-		forwarded_values {
-			query_string = true
-			cookies {
-				forward = "none"
-			}
-		}
-		min_ttl = 50
-		default_ttl = 50
-		max_ttl = 50
-		viewer_protocol_policy = "allow-all"
-		path_pattern = "images1/*.jpg"
-	}
-	ordered_cache_behavior {
-		allowed_methods = [ "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT" ]
-		cached_methods = [ "GET", "HEAD" ]
-		target_origin_id = "myCustomOrigin"
-		forwarded_values {
-			query_string = true
-			cookies {
-				forward = "none"
-			}
-		}
-		min_ttl = 51
-		default_ttl = 51
-		max_ttl = 51
-		viewer_protocol_policy = "allow-all"
-		path_pattern = "images2/*.jpg"
-	}
-
-	price_class = "PriceClass_All"
-	restrictions {
-		geo_restriction {
-			restriction_type = "none"
-		}
-	}
-	viewer_certificate {
-		cloudfront_default_certificate = true
-	}
-	%s
+`, restrictionType, locations, testAccAWSCloudFrontDistributionRetainConfig())
 }
-`, acctest.RandInt(), testAccAWSCloudFrontDistributionRetainConfig())
@@ -56,6 +56,7 @@ func TestAccAWSCloudFrontPublicKey_namePrefix(t *testing.T) {
 
 func TestAccAWSCloudFrontPublicKey_update(t *testing.T) {
 	rInt := acctest.RandInt()
+	var id string
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -66,6 +67,7 @@ func TestAccAWSCloudFrontPublicKey_update(t *testing.T) {
 				Config: testAccAWSCloudFrontPublicKeyConfig(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudFrontPublicKeyExistence("aws_cloudfront_public_key.example"),
+					testAccCheckCloudFrontPublicKeySaveId("aws_cloudfront_public_key.example", &id),
 					resource.TestCheckResourceAttr("aws_cloudfront_public_key.example", "comment", "test key"),
 				),
 			},
@@ -73,6 +75,8 @@ func TestAccAWSCloudFrontPublicKey_update(t *testing.T) {
 				Config: testAccAWSCloudFrontPublicKeyConfigUpdate(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudFrontPublicKeyExistence("aws_cloudfront_public_key.example"),
+					// Updating the comment must not replace the key
+					testAccCheckCloudFrontPublicKeyIdUnchanged("aws_cloudfront_public_key.example", &id),
 					resource.TestCheckResourceAttr("aws_cloudfront_public_key.example", "comment", "test key1"),
 				),
 			},
@@ -104,6 +108,34 @@ func testAccCheckCloudFrontPublicKeyExistence(r string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckCloudFrontPublicKeySaveId(r string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return fmt.Errorf("Not found: %s", r)
+		}
+
+		*id = rs.Primary.ID
+
+		return nil
+	}
+}
+
+func testAccCheckCloudFrontPublicKeyIdUnchanged(r string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return fmt.Errorf("Not found: %s", r)
+		}
+
+		if rs.Primary.ID != *id {
+			return fmt.Errorf("CloudFront PublicKey was recreated, ID changed from %q to %q", *id, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckCloudFrontPublicKeyDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
 
@@ -8,11 +8,14 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+// setTagsCloudFront applies a change to tags, including the provider's
+// default tags that the resource's tags do not override. The tags to remove
+// are taken from tags_all, which holds every tag read from CloudFront.
+func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string, defaultTags map[string]interface{}) error {
+	if d.HasChange("tags") || d.HasChange("tags_all") {
+		oraw, _ := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+		n := tagsWithDefaultsCloudFront(defaultTags, d.Get("tags").(map[string]interface{}))
 		create, remove := diffTagsCloudFront(tagsFromMapCloudFront(o), tagsFromMapCloudFront(n))
 
 		if len(remove) > 0 {
@@ -50,19 +53,25 @@ func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn
 
 	return nil
 }
+
+// diffTagsCloudFront returns the tags to create and the tags to remove to go
+// from oldTags to newTags. Only added or changed tags are created, and a
+// changed tag is not removed first, as TagResource overwrites its value. Only
+// tags whose key is no longer present are removed.
 func diffTagsCloudFront(oldTags, newTags *cloudfront.Tags) ([]*cloudfront.Tag, []*cloudfront.Tag) {
-	// First, we're creating everything we have
+	oldMap := tagsToMapCloudFront(oldTags)
+	newMap := tagsToMapCloudFront(newTags)
+
 	create := make(map[string]interface{})
-	for _, t := range newTags.Items {
-		create[*t.Key] = *t.Value
+	for k, v := range newMap {
+		if old, ok := oldMap[k]; !ok || old != v {
+			create[k] = v
+		}
 	}
 
-	// Build the list of what to remove
 	var remove []*cloudfront.Tag
 	for _, t := range oldTags.Items {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
+		if _, ok := newMap[aws.StringValue(t.Key)]; !ok {
 			remove = append(remove, t)
 		}
 	}
@@ -96,3 +105,35 @@ func tagsToMapCloudFront(ts *cloudfront.Tags) map[string]string {
 
 	return result
 }
+
+// tagsWithDefaultsCloudFront returns tags merged with the provider's default
+// tags. tags take precedence over defaults with the same key.
+func tagsWithDefaultsCloudFront(defaultTags, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// tagsWithoutDefaultsCloudFront removes the provider's default tags from tags
+// read from CloudFront, so that they do not show up as a difference from the
+// configuration. A default tag is kept if configured sets the same key, or if
+// its value was changed outside of Terraform.
+func tagsWithoutDefaultsCloudFront(defaultTags map[string]interface{}, tags map[string]string, configured map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if dv, ok := defaultTags[k]; ok && dv.(string) == v {
+			if _, ok := configured[k]; !ok {
+				continue
+			}
+		}
+		result[k] = v
+	}
+
+	return result
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
+// go test -v -run="TestDiffCloudFrontTags"
+func TestDiffCloudFrontTags(t *testing.T) {
+	cases := []struct {
+		Old, New       map[string]interface{}
+		Create, Remove map[string]string
+	}{
+		// Add
+		{
+			Old: map[string]interface{}{
+				"foo": "bar",
+			},
+			New: map[string]interface{}{
+				"foo": "bar",
+				"bar": "baz",
+			},
+			Create: map[string]string{
+				"bar": "baz",
+			},
+			Remove: map[string]string{},
+		},
+
+		// Modify
+		{
+			Old: map[string]interface{}{
+				"foo": "bar",
+			},
+			New: map[string]interface{}{
+				"foo": "baz",
+			},
+			Create: map[string]string{
+				"foo": "baz",
+			},
+			Remove: map[string]string{},
+		},
+
+		// Overlap
+		{
+			Old: map[string]interface{}{
+				"foo":   "bar",
+				"hello": "world",
+			},
+			New: map[string]interface{}{
+				"foo":   "baz",
+				"hello": "world",
+			},
+			Create: map[string]string{
+				"foo": "baz",
+			},
+			Remove: map[string]string{},
+		},
+
+		// Remove
+		{
+			Old: map[string]interface{}{
+				"foo": "bar",
+				"bar": "baz",
+			},
+			New: map[string]interface{}{
+				"foo": "bar",
+			},
+			Create: map[string]string{},
+			Remove: map[string]string{
+				"bar": "baz",
+			},
+		},
+
+		// Add, modify and remove
+		{
+			Old: map[string]interface{}{
+				"foo":   "bar",
+				"bar":   "baz",
+				"hello": "world",
+			},
+			New: map[string]interface{}{
+				"foo":  "baz",
+				"bar":  "baz",
+				"fizz": "buzz",
+			},
+			Create: map[string]string{
+				"foo":  "baz",
+				"fizz": "buzz",
+			},
+			Remove: map[string]string{
+				"hello": "world",
+			},
+		},
+
+		// Unchanged
+		{
+			Old: map[string]interface{}{
+				"foo": "bar",
+			},
+			New: map[string]interface{}{
+				"foo": "bar",
+			},
+			Create: map[string]string{},
+			Remove: map[string]string{},
+		},
+	}
+
+	for i, tc := range cases {
+		c, r := diffTagsCloudFront(tagsFromMapCloudFront(tc.Old), tagsFromMapCloudFront(tc.New))
+		cm := tagsToMapCloudFront(&cloudfront.Tags{Items: c})
+		rm := tagsToMapCloudFront(&cloudfront.Tags{Items: r})
+		if !reflect.DeepEqual(cm, tc.Create) {
+			t.Fatalf("%d: bad create: %#v", i, cm)
+		}
+		if !reflect.DeepEqual(rm, tc.Remove) {
+			t.Fatalf("%d: bad remove: %#v", i, rm)
+		}
+	}
+}
@@ -10,7 +10,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -2280,6 +2282,218 @@ func validateCloudFrontPublicKeyNamePrefix(v interface{}, k string) (ws []string
 	return
 }
 
+// validateCloudFrontDistributionAlias checks that the value is a domain name
+// with an optional leading wildcard label, e.g. www.example.com or
+// *.example.com.
+func validateCloudFrontDistributionAlias(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) > 253 {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot be longer than 253 characters", k, value))
+		return
+	}
+
+	labels := strings.Split(value, ".")
+	if labels[0] == "*" {
+		labels = labels[1:]
+	}
+	if len(labels) < 2 {
+		errors = append(errors, fmt.Errorf("%q (%s) must be a domain name with at least two labels, optionally preceded by a single *. wildcard label", k, value))
+		return
+	}
+
+	label := regexp.MustCompile(`^[0-9A-Za-z]([0-9A-Za-z-]{0,61}[0-9A-Za-z])?$`)
+	for _, l := range labels {
+		if strings.Contains(l, "*") {
+			errors = append(errors, fmt.Errorf("%q (%s) can only contain a wildcard as the whole of its first label", k, value))
+			return
+		}
+		if !label.MatchString(l) {
+			errors = append(errors, fmt.Errorf("%q (%s) contains an invalid label %q", k, value, l))
+			return
+		}
+	}
+
+	return
+}
+
+func validateCloudFrontOriginGroupFailoverStatusCode(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	// https://docs.aws.amazon.com/cloudfront/latest/APIReference/API_StatusCodes.html
+	validCodes := []int{403, 404, 416, 500, 502, 503, 504}
+	for _, code := range validCodes {
+		if value == code {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf(
+		"%q (%d) must be one of %v", k, value, validCodes))
+	return
+}
+
+func validateCloudFrontCustomErrorResponseErrorCode(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	// https://docs.aws.amazon.com/cloudfront/latest/APIReference/API_CustomErrorResponse.html
+	validCodes := []int{400, 403, 404, 405, 414, 416, 500, 501, 502, 503, 504}
+	for _, code := range validCodes {
+		if value == code {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf(
+		"%q (%d) must be one of %v", k, value, validCodes))
+	return
+}
+
+// validateCloudFrontLambdaFunctionArn checks that a Lambda@Edge function is
+// referenced by a published version, e.g.
+// arn:aws:lambda:us-east-1:123456789012:function:example:1
+// CloudFront rejects unqualified ARNs, aliases and $LATEST.
+func validateCloudFrontLambdaFunctionArn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	parsedArn, err := arn.Parse(value)
+	if err != nil || parsedArn.Service != "lambda" || !strings.HasPrefix(parsedArn.Resource, "function:") {
+		errors = append(errors, fmt.Errorf("%q (%s) must be the ARN of a Lambda function version", k, value))
+		return
+	}
+
+	parts := strings.Split(parsedArn.Resource, ":")
+	switch {
+	case len(parts) != 3:
+		errors = append(errors, fmt.Errorf("%q (%s) is unqualified, Lambda@Edge requires a function version ARN such as %s:1, use the function's qualified_arn", k, value, value))
+	case parts[2] == "$LATEST":
+		errors = append(errors, fmt.Errorf("%q (%s) refers to $LATEST, Lambda@Edge requires a published function version", k, value))
+	case !regexp.MustCompile(`^[0-9]+$`).MatchString(parts[2]):
+		errors = append(errors, fmt.Errorf("%q (%s) must end with a numeric function version, Lambda@Edge does not support aliases", k, value))
+	}
+	return
+}
+
+// validateCloudFrontDistributionDefaultRootObject checks the object name the
+// way CloudFront does when the distribution is created or updated: at most
+// 255 characters and no leading slash.
+func validateCloudFrontDistributionDefaultRootObject(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if strings.HasPrefix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q (%s) must not start with a slash, use e.g. %q", k, value, strings.TrimLeft(value, "/")))
+	}
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 255 characters, got %d", k, len(value)))
+	}
+	return
+}
+
+// validateCloudFrontWebAclArn checks that the value is the ARN of a WAFv2 web
+// ACL with CLOUDFRONT scope, e.g.
+// arn:aws:wafv2:us-east-1:123456789012:global/webacl/example/a1b2c3d4
+func validateCloudFrontWebAclArn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+
+	parsedArn, err := arn.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	if parsedArn.Service != "wafv2" {
+		errors = append(errors, fmt.Errorf("%q (%s) must be a WAFv2 web ACL ARN", k, value))
+	}
+	if !strings.HasPrefix(parsedArn.Resource, "global/webacl/") {
+		errors = append(errors, fmt.Errorf("%q (%s) must be the ARN of a web ACL with CLOUDFRONT scope", k, value))
+	}
+	return
+}
+
+// validateCloudFrontDistributionHttpVersion accepts the HTTP versions
+// CloudFront supports. HTTP/3 is only served from edge locations that support
+// it, with viewers in other locations falling back to HTTP/2, so setting it
+// produces a warning rather than an error.
+func validateCloudFrontDistributionHttpVersion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case cloudfront.HttpVersionHttp11, cloudfront.HttpVersionHttp2:
+	case "http2and3", "http3":
+		ws = append(ws, fmt.Sprintf("%q (%s) enables HTTP/3, which is not available from every CloudFront edge location, viewers served elsewhere fall back to an earlier HTTP version", k, value))
+	default:
+		errors = append(errors, fmt.Errorf("%q (%s) must be one of %s, %s, http2and3 or http3", k, value, cloudfront.HttpVersionHttp11, cloudfront.HttpVersionHttp2))
+	}
+	return
+}
+
+// validateCloudFrontDistributionSslSupportMethod accepts sni-only and vip,
+// warning for vip as dedicated IP addresses are billed monthly for as long as
+// they are enabled.
+func validateCloudFrontDistributionSslSupportMethod(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case cloudfront.SSLSupportMethodSniOnly:
+	case cloudfront.SSLSupportMethodVip:
+		ws = append(ws, fmt.Sprintf("%q (%s) uses dedicated IP addresses, which incur monthly charges for as long as they are enabled. Use %s unless viewers need to connect without SNI", k, value, cloudfront.SSLSupportMethodSniOnly))
+	default:
+		errors = append(errors, fmt.Errorf("%q (%s) must be one of %s or %s", k, value, cloudfront.SSLSupportMethodSniOnly, cloudfront.SSLSupportMethodVip))
+	}
+	return
+}
+
+func validateCloudFrontDistributionDeleteAfter(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %s", k, err))
+		return
+	}
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+	return
+}
+
+// validateCloudFrontOriginReadTimeout accepts custom origin read timeouts up
+// to the service maximum of 180 seconds. Values above the default quota of
+// 60 seconds only work once a quota increase has been granted, so they
+// produce a warning rather than an error.
+func validateCloudFrontOriginReadTimeout(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < 1 || value > 180 {
+		errors = append(errors, fmt.Errorf("%q (%d) must be between 1 and 180 seconds", k, value))
+		return
+	}
+	if value > 60 {
+		ws = append(ws, fmt.Sprintf("%q (%d) is above the default quota of 60 seconds, CloudFront rejects it unless a quota increase for the origin response timeout has been granted for the account", k, value))
+	}
+	return
+}
+
+// validateCloudFrontDistributionLoggingBucket warns when the logging bucket
+// domain name places the bucket in a region that CloudFront standard logging
+// does not deliver to. Region support changes over time, so this is not an
+// error.
+func validateCloudFrontDistributionLoggingBucket(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if region := cloudFrontLoggingBucketRegion(value); cloudFrontLoggingUnsupportedRegions[region] {
+		ws = append(ws, fmt.Sprintf("%q (%s) is in %s, which CloudFront standard logging does not deliver to, so no access logs will appear. See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/AccessLogs.html#access-logs-choosing-s3-bucket", k, value, region))
+	}
+	return
+}
+
+// validateCloudFrontOriginSslProtocol accepts the SSL/TLS protocols CloudFront
+// can use with a custom origin. Protocols older than TLSv1.2 remain valid for
+// legacy origins that cannot be upgraded, so they only produce a warning.
+func validateCloudFrontOriginSslProtocol(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case cloudfront.SslProtocolTlsv12:
+	case cloudfront.SslProtocolSslv3, cloudfront.SslProtocolTlsv1, cloudfront.SslProtocolTlsv11:
+		ws = append(ws, fmt.Sprintf("%q (%s) is deprecated, consider allowing only %s", k, value, cloudfront.SslProtocolTlsv12))
+	default:
+		errors = append(errors, fmt.Errorf("%q (%s) must be one of %s, %s, %s or %s", k, value, cloudfront.SslProtocolSslv3, cloudfront.SslProtocolTlsv1, cloudfront.SslProtocolTlsv11, cloudfront.SslProtocolTlsv12))
+	}
+	return
+}
+
 func validateServiceDiscoveryHttpNamespaceName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[0-9A-Za-z_-]+$`).MatchString(value) {
@@ -2928,6 +2928,319 @@ func TestValidateCloudFrontPublicKeyName(t *testing.T) {
 	}
 }
 
+func TestValidateCloudFrontDistributionAlias(t *testing.T) {
+	validNames := []string{
+		"example.com",
+		"www.example.com",
+		"*.example.com",
+		"my-site.example.co.uk",
+		"xn--bcher-kva.example",
+	}
+	for _, v := range validNames {
+		_, errors := validateCloudFrontDistributionAlias(v, "aliases")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid alias: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"*",
+		"*.com",
+		"*.*.example.com",
+		"www.*.example.com",
+		"foo*.example.com",
+		"example",
+		"-example.com",
+		"example-.com",
+		"www..example.com",
+		"example.com.",
+		"under_score.example.com",
+		strings.Repeat("a", 64) + ".example.com",
+		strings.Repeat("a.", 127) + "com",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateCloudFrontDistributionAlias(v, "aliases")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid alias", v)
+		}
+	}
+}
+
+func TestValidateCloudFrontOriginGroupFailoverStatusCode(t *testing.T) {
+	validCodes := []int{403, 404, 416, 500, 502, 503, 504}
+	for _, v := range validCodes {
+		_, errors := validateCloudFrontOriginGroupFailoverStatusCode(v, "status_codes")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid failover status code: %q", v, errors)
+		}
+	}
+
+	invalidCodes := []int{0, 200, 401, 501, 505}
+	for _, v := range invalidCodes {
+		_, errors := validateCloudFrontOriginGroupFailoverStatusCode(v, "status_codes")
+		if len(errors) == 0 {
+			t.Fatalf("%d should be an invalid failover status code", v)
+		}
+	}
+}
+
+func TestValidateCloudFrontCustomErrorResponseErrorCode(t *testing.T) {
+	validCodes := []int{400, 403, 404, 405, 414, 416, 500, 501, 502, 503, 504}
+	for _, v := range validCodes {
+		_, errors := validateCloudFrontCustomErrorResponseErrorCode(v, "error_code")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid custom error response error code: %q", v, errors)
+		}
+	}
+
+	invalidCodes := []int{0, 200, 401, 418, 505}
+	for _, v := range invalidCodes {
+		_, errors := validateCloudFrontCustomErrorResponseErrorCode(v, "error_code")
+		if len(errors) == 0 {
+			t.Fatalf("%d should be an invalid custom error response error code", v)
+		}
+	}
+}
+
+func TestValidateCloudFrontLambdaFunctionArn(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "arn:aws:lambda:us-east-1:123456789012:function:example:1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws:lambda:us-east-1:123456789012:function:example:42",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws:lambda:us-east-1:123456789012:function:example",
+			ErrCount: 1,
+		},
+		{
+			Value:    "arn:aws:lambda:us-east-1:123456789012:function:example:$LATEST",
+			ErrCount: 1,
+		},
+		{
+			Value:    "arn:aws:lambda:us-east-1:123456789012:function:example:live",
+			ErrCount: 1,
+		},
+		{
+			Value:    "arn:aws:s3:::example",
+			ErrCount: 1,
+		},
+		{
+			Value:    "example",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateCloudFrontLambdaFunctionArn(tc.Value, "lambda_arn")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q, got %d: %q", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
+
+func TestValidateCloudFrontDistributionDefaultRootObject(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 0,
+		},
+		{
+			Value:    "index.html",
+			ErrCount: 0,
+		},
+		{
+			Value:    "docs/index.html",
+			ErrCount: 0,
+		},
+		{
+			Value:    "/index.html",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 255),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 256),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateCloudFrontDistributionDefaultRootObject(tc.Value, "default_root_object")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q, got %d: %q", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
+
+func TestValidateCloudFrontWebAclArn(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws:wafv2:us-east-1:123456789012:global/webacl/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			ErrCount: 1,
+		},
+		{
+			Value:    "arn:aws:waf::123456789012:webacl/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			ErrCount: 2,
+		},
+		{
+			Value:    "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateCloudFrontWebAclArn(tc.Value, "web_acl_arn")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d: %v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
+
+func TestValidateCloudFrontDistributionDeleteAfter(t *testing.T) {
+	validDurations := []string{"0s", "15m", "1h30m"}
+	for _, v := range validDurations {
+		_, errors := validateCloudFrontDistributionDeleteAfter(v, "delete_after")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid delete_after duration: %q", v, errors)
+		}
+	}
+
+	invalidDurations := []string{"", "15", "1 hour", "-5m"}
+	for _, v := range invalidDurations {
+		_, errors := validateCloudFrontDistributionDeleteAfter(v, "delete_after")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid delete_after duration", v)
+		}
+	}
+}
+
+func TestValidateCloudFrontDistributionHttpVersion(t *testing.T) {
+	cases := []struct {
+		Value     string
+		WarnCount int
+		ErrCount  int
+	}{
+		{Value: "http1.1"},
+		{Value: "http2"},
+		{Value: "http2and3", WarnCount: 1},
+		{Value: "http3", WarnCount: 1},
+		{Value: "http1.0", ErrCount: 1},
+		{Value: "HTTP2", ErrCount: 1},
+		{Value: "", ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		warnings, errors := validateCloudFrontDistributionHttpVersion(tc.Value, "http_version")
+
+		if len(warnings) != tc.WarnCount {
+			t.Fatalf("Expected %d warnings for %q, got %d: %q", tc.WarnCount, tc.Value, len(warnings), warnings)
+		}
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q, got %d: %q", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
+
+func TestValidateCloudFrontDistributionSslSupportMethod(t *testing.T) {
+	cases := []struct {
+		Value     string
+		WarnCount int
+		ErrCount  int
+	}{
+		{Value: "sni-only"},
+		{Value: "vip", WarnCount: 1},
+		{Value: "static-ip", ErrCount: 1},
+		{Value: "VIP", ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		warnings, errors := validateCloudFrontDistributionSslSupportMethod(tc.Value, "ssl_support_method")
+
+		if len(warnings) != tc.WarnCount {
+			t.Fatalf("Expected %d warnings for %q, got %d: %q", tc.WarnCount, tc.Value, len(warnings), warnings)
+		}
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q, got %d: %q", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
+
+func TestValidateCloudFrontOriginReadTimeout(t *testing.T) {
+	cases := []struct {
+		Value     int
+		WarnCount int
+		ErrCount  int
+	}{
+		{Value: 0, ErrCount: 1},
+		{Value: 1},
+		{Value: 60},
+		{Value: 61, WarnCount: 1},
+		{Value: 180, WarnCount: 1},
+		{Value: 181, ErrCount: 1},
+	}
+
+	for _, tc := range cases {
+		warnings, errors := validateCloudFrontOriginReadTimeout(tc.Value, "origin_read_timeout")
+
+		if len(warnings) != tc.WarnCount {
+			t.Fatalf("Expected %d warnings for %d, got %d: %q", tc.WarnCount, tc.Value, len(warnings), warnings)
+		}
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %d, got %d: %q", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
+
+func TestValidateCloudFrontDistributionLoggingBucket(t *testing.T) {
+	cases := []struct {
+		Value     string
+		WarnCount int
+	}{
+		{Value: "mylogs.s3.amazonaws.com"},
+		{Value: "mylogs.s3.eu-west-1.amazonaws.com"},
+		{Value: "mylogs.s3.af-south-1.amazonaws.com", WarnCount: 1},
+		{Value: "mylogs.s3-eu-south-1.amazonaws.com", WarnCount: 1},
+	}
+
+	for _, tc := range cases {
+		warnings, errors := validateCloudFrontDistributionLoggingBucket(tc.Value, "bucket")
+
+		if len(warnings) != tc.WarnCount {
+			t.Fatalf("Expected %d warnings for %q, got %d: %q", tc.WarnCount, tc.Value, len(warnings), warnings)
+		}
+		if len(errors) != 0 {
+			t.Fatalf("Expected no errors for %q, got %d: %q", tc.Value, len(errors), errors)
+		}
+	}
+}
+
 func TestValidateCloudFrontPublicKeyNamePrefix(t *testing.T) {
 	cases := []struct {
 		Value    string